@@ -0,0 +1,7 @@
+#!/bin/bash
+
+docker-compose down
+
+docker-compose run recipe_api_tests
+
+docker-compose down
\ No newline at end of file
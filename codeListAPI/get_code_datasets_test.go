@@ -0,0 +1,90 @@
+package codeListAPI
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSuccessfullyGetDatasetsUsingACode(t *testing.T) {
+
+	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
+
+	Convey("Given a code is used by a published and an unpublished dataset", t, func() {
+		Convey("When you request the datasets that use the code", func() {
+			Convey("Then only the published dataset should appear", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}/datasets", firstCodeListID, firstCodeListEdition, firstCodeListFirstCodeID).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("items").Array().Length().Equal(1)
+
+				dataset := response.Value("items").Array().First().Object()
+				dataset.Value("dimension_label").Equal(firstCodeListFirstLabel)
+				dataset.Value("links").Object().Value("self").Object().Value("id").Equal(publishedDatasetID)
+				dataset.Value("links").Object().Value("self").Object().Value("href").String().Match("(.+)/datasets/" + publishedDatasetID + "$")
+
+				edition := dataset.Value("editions").Array().First().Object().Value("links").Object()
+				edition.Value("self").Object().Value("id").Equal(datasetEdition)
+				edition.Value("self").Object().Value("href").String().Match("(.+)/datasets/" + publishedDatasetID + "/editions/" + datasetEdition + "$")
+				edition.Value("latest_version").Object().Value("id").Equal("1")
+				edition.Value("latest_version").Object().Value("href").String().Match("(.+)/datasets/" + publishedDatasetID + "/editions/" + datasetEdition + "/versions/1$")
+			})
+		})
+	})
+
+	Convey("Given a code is only used by datasets of a later edition of the code list", t, func() {
+		Convey("When you request the datasets that use the code for that edition", func() {
+			Convey("Then the dataset using that edition should appear", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}/datasets", firstCodeListID, firstCodeListSecondEdition, firstCodeListNewCodeID).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("items").Array().Length().Equal(1)
+				response.Value("items").Array().First().Object().
+					Value("links").Object().Value("self").Object().Value("id").Equal(secondEditionDatasetID)
+			})
+		})
+	})
+
+	Convey("Given a code is not used by any dataset", t, func() {
+		Convey("When you request the datasets that use the code", func() {
+			Convey("Then an empty list of datasets should appear", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}/datasets", firstCodeListID, firstCodeListEdition, firstCodeListThirdCodeID).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(0)
+			})
+		})
+	})
+}
+
+func TestFailureToGetDatasetsUsingACode(t *testing.T) {
+	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
+
+	Convey("Given a code list and codes exists", t, func() {
+		Convey("When you pass a code list that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}/datasets", invalidCodeListID, firstCodeListEdition, firstCodeListFirstCodeID).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you pass an edition that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}/datasets", firstCodeListID, invalidEdition, firstCodeListFirstCodeID).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you pass a code that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}/datasets", firstCodeListID, firstCodeListEdition, invalidCode).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+	})
+}
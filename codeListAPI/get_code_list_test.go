@@ -6,7 +6,6 @@ import (
 
 	"github.com/gavv/httpexpect"
 	. "github.com/smartystreets/goconvey/convey"
-
 )
 
 func TestSuccessfullyGetACodeList(t *testing.T) {
@@ -26,6 +25,17 @@ func TestSuccessfullyGetACodeList(t *testing.T) {
 
 			})
 		})
+
+		Convey("When you request a code list with more than one edition", func() {
+			Convey("Then the code list data should appear once", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}", firstCodeListID).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("links").Object().Value("self").Object().Value("id").Equal(firstCodeListID)
+				response.Value("links").Object().Value("editions").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "/editions$")
+			})
+		})
 	})
 
 }
@@ -34,8 +44,7 @@ func TestFailureToGetACodeList(t *testing.T) {
 
 	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
 
-	// TODO Dont skip test once endpoint has been refactored
-	SkipConvey("Given a code list exists", t, func() {
+	Convey("Given a code list exists", t, func() {
 		Convey("When you pass a code list that does not exist", func() {
 			Convey("Then the response should be status not found (404)", func() {
 				codeListAPI.GET("/code-lists/{id}", invalidCodeListID).
@@ -6,7 +6,6 @@ import (
 
 	"github.com/gavv/httpexpect"
 	. "github.com/smartystreets/goconvey/convey"
-
 )
 
 func TestSuccessfullyGetASetOfCodeLists(t *testing.T) {
@@ -20,20 +19,24 @@ func TestSuccessfullyGetASetOfCodeLists(t *testing.T) {
 				response := codeListAPI.GET("/code-lists").
 					Expect().Status(http.StatusOK).JSON().Object()
 
-				//checking array length is alwaysgreather than 3
-				response.Value("items").Array().Length().Equal(2)
-				response.Value("items").Array().Element(0).Object().Value("links").Object().Value("self").Object().Value("id").Equal(firstCodeListID)
-				response.Value("items").Array().Element(0).Object().Value("links").Object().Value("self").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "$")
-				response.Value("items").Array().Element(0).Object().Value("links").Object().Value("editions").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "/editions$")
+				// other code lists may exist, so only check the seeded ones are listed
+				response.Path("$.items[*].links.self.id").Array().Contains(firstCodeListID, secondCodeListID)
+
+				for _, item := range response.Value("items").Array().Iter() {
+					links := item.Object().Value("links").Object()
+					id := links.Value("self").Object().Value("id").String().Raw()
+					if id != firstCodeListID && id != secondCodeListID {
+						continue
+					}
 
-				response.Value("items").Array().Element(1).Object().Value("links").Object().Value("self").Object().Value("id").Equal(secondCodeListID)
+					links.Value("self").Object().Value("href").String().Match("(.+)/code-lists/" + id + "$")
+					links.Value("editions").Object().Value("href").String().Match("(.+)/code-lists/" + id + "/editions$")
+				}
 
 				// This functionality is not implemented yet.
 				//response.Value("number_of_results").Equal(6)
 			})
 		})
 	})
-	
-}
 
-// TODO Need to write failure tests
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/gavv/httpexpect"
 	. "github.com/smartystreets/goconvey/convey"
-
 )
 
 func TestSuccessfullyGetCodeInformationAboutACode(t *testing.T) {
@@ -17,38 +16,31 @@ func TestSuccessfullyGetCodeInformationAboutACode(t *testing.T) {
 		Convey("When you request a specific code information", func() {
 			Convey("Then that particular code information about that code should appear", func() {
 
-				// first code information
-				firstCodeResponse := codeListAPI.GET("/code-lists/{}/editions/{}/codes/{}", firstCodeListID, firstCodeListEdition, firstCodeListFirstCodeID).
-					Expect().Status(http.StatusOK).JSON().Object()
-
-				firstCodeResponse.Value("id").Equal(firstCodeListFirstCodeID)
-				firstCodeResponse.Value("label").Equal(firstCodeListFirstLabel)
-				firstCodeResponse.Value("links").Object().Value("code_list").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID)
-				firstCodeResponse.Value("links").Object().Value("self").Object().Value("href").String().
-					Match("(.+)/code-lists/" + firstCodeListID + "/editions/" + firstCodeListEdition + "/codes/" + firstCodeListFirstCodeID)
-
-
-				// second code information
-				secondCodeResponse := codeListAPI.GET("/code-lists/{}/editions/{}/codes/{}", firstCodeListID, firstCodeListEdition, firstCodeListSecondCodeID).
-					Expect().Status(http.StatusOK).JSON().Object()
-
-				secondCodeResponse.Value("id").Equal(firstCodeListSecondCodeID)
-				secondCodeResponse.Value("label").Equal(firstCodeListSecondLabel)
-				secondCodeResponse.Value("links").Object().Value("code_list").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID)
-				secondCodeResponse.Value("links").Object().Value("self").Object().Value("href").String().
-					Match("(.+)/code-lists/" + firstCodeListID + "/editions/" + firstCodeListEdition + "/codes/" + firstCodeListSecondCodeID)
+				codes := []struct {
+					id    string
+					label string
+				}{
+					{firstCodeListFirstCodeID, firstCodeListFirstLabel},
+					{firstCodeListSecondCodeID, firstCodeListSecondLabel},
+					{firstCodeListThirdCodeID, firstCodeListThirdLabel},
+				}
+
+				for _, c := range codes {
+					response := codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}", firstCodeListID, firstCodeListEdition, c.id).
+						Expect().Status(http.StatusOK).JSON().Object()
+
+					checkCode(response, firstCodeListID, firstCodeListEdition, c.id, c.label)
+				}
+			})
+		})
 
+		Convey("When you request a code that has a different label in a later edition", func() {
+			Convey("Then the label for the requested edition should appear", func() {
 
-				// third code information
-				thirdCodeResponse := codeListAPI.GET("/code-lists/{}/editions/{}/codes/{}", firstCodeListID, firstCodeListEdition, firstCodeListThirdCodeID).
+				response := codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}", firstCodeListID, firstCodeListSecondEdition, firstCodeListFirstCodeID).
 					Expect().Status(http.StatusOK).JSON().Object()
 
-				thirdCodeResponse.Value("id").Equal(firstCodeListThirdCodeID)
-				thirdCodeResponse.Value("label").Equal(firstCodeListThirdLabel)
-				thirdCodeResponse.Value("links").Object().Value("code_list").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID)
-				thirdCodeResponse.Value("links").Object().Value("self").Object().Value("href").String().
-					Match("(.+)/code-lists/" + firstCodeListID + "/editions/" + firstCodeListEdition + "/codes/" + firstCodeListThirdCodeID)
-
+				checkCode(response, firstCodeListID, firstCodeListSecondEdition, firstCodeListFirstCodeID, firstCodeListSecondEditionLabel)
 			})
 		})
 	})
@@ -58,18 +50,31 @@ func TestSuccessfullyGetCodeInformationAboutACode(t *testing.T) {
 func TestFailureToGetInDepthInformationAboutACode(t *testing.T) {
 	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
 
-	// TODO Dont skip test once endpoint has been refactored
-	SkipConvey("Given a code list and codes exists", t, func() {
+	Convey("Given a code list and codes exists", t, func() {
 		Convey("When you pass a code list that does not exist", func() {
 			Convey("Then the response should be status not found (404)", func() {
-				codeListAPI.GET("/code-lists/{id}/editions/{}/codes/{code_id}", invalidCodeListID, firstCodeListEdition, firstCode).
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}", invalidCodeListID, firstCodeListEdition, firstCodeListFirstCodeID).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you pass an edition that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}", firstCodeListID, invalidEdition, firstCodeListFirstCodeID).
 					Expect().Status(http.StatusNotFound)
 			})
 		})
 
 		Convey("When you pass a code that does not exist", func() {
 			Convey("Then the response should be status not found (404)", func() {
-				codeListAPI.GET("/code-lists/{id}/editions/{}/codes/{code_id}", firstCodeListID, firstCodeListEdition, invalidCode).
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}", firstCodeListID, firstCodeListEdition, invalidCode).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you pass a code that is not used by the requested edition", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes/{code_id}", firstCodeListID, firstCodeListEdition, firstCodeListNewCodeID).
 					Expect().Status(http.StatusNotFound)
 			})
 		})
@@ -6,7 +6,6 @@ import (
 
 	"github.com/gavv/httpexpect"
 	. "github.com/smartystreets/goconvey/convey"
-
 )
 
 func TestSuccessfullyGetAListOfAllCodesWithinCodeList(t *testing.T) {
@@ -17,24 +16,46 @@ func TestSuccessfullyGetAListOfAllCodesWithinCodeList(t *testing.T) {
 		Convey("When you request a list of all codes", func() {
 			Convey("Then the list of codes within a code list should appear", func() {
 
-				response := codeListAPI.GET("/code-lists/{id}/editions/one-off/codes", firstCodeListID).
+				response := codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes", firstCodeListID, firstCodeListEdition).
 					Expect().Status(http.StatusOK).JSON().Object()
 
-				response.Value("items").Array().Element(0).Object().Value("id").Equal(firstCode)
-				response.Value("items").Array().Element(0).Object().Value("label").Equal(firstCodeListFirstLabel)
-				response.Value("items").Array().Element(0).Object().Value("links").Object().Value("code_list").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "$")
-				response.Value("items").Array().Element(0).Object().Value("links").Object().Value("self").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "/editions/")
+				response.Value("items").Array().Length().Equal(3)
+				response.Path("$.items[*].id").Array().ContainsOnly(firstCodeListFirstCodeID, firstCodeListSecondCodeID, firstCodeListThirdCodeID)
+
+				labels := map[string]string{
+					firstCodeListFirstCodeID:  firstCodeListFirstLabel,
+					firstCodeListSecondCodeID: firstCodeListSecondLabel,
+					firstCodeListThirdCodeID:  firstCodeListThirdLabel,
+				}
+
+				for _, item := range response.Value("items").Array().Iter() {
+					code := item.Object()
+					id := code.Value("id").String().Raw()
+					checkCode(code, firstCodeListID, firstCodeListEdition, id, labels[id])
+				}
+			})
+		})
+
+		Convey("When you request a list of all codes of a later edition", func() {
+			Convey("Then only the codes of that edition should appear, with their labels for that edition", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes", firstCodeListID, firstCodeListSecondEdition).
+					Expect().Status(http.StatusOK).JSON().Object()
 
-				response.Value("items").Array().Element(1).Object().Value("id").Equal(secondCode)
-				response.Value("items").Array().Element(1).Object().Value("label").Equal(firstCodeListSecondLabel)
-				response.Value("items").Array().Element(1).Object().Value("links").Object().Value("code_list").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "$")
-				response.Value("items").Array().Element(1).Object().Value("links").Object().Value("self").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "/editions/")
+				response.Value("items").Array().Length().Equal(3)
+				response.Path("$.items[*].id").Array().ContainsOnly(firstCodeListFirstCodeID, firstCodeListSecondCodeID, firstCodeListNewCodeID)
 
-				response.Value("items").Array().Element(2).Object().Value("id").Equal(thirdCode)
-				response.Value("items").Array().Element(2).Object().Value("label").Equal(firstCodeListThirdLabel)
-				response.Value("items").Array().Element(2).Object().Value("links").Object().Value("code_list").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "$")
-				response.Value("items").Array().Element(2).Object().Value("links").Object().Value("self").Object().Value("href").String().Match("(.+)/code-lists/" + firstCodeListID + "/editions/")
+				labels := map[string]string{
+					firstCodeListFirstCodeID:  firstCodeListSecondEditionLabel,
+					firstCodeListSecondCodeID: firstCodeListSecondLabel,
+					firstCodeListNewCodeID:    firstCodeListNewCodeLabel,
+				}
 
+				for _, item := range response.Value("items").Array().Iter() {
+					code := item.Object()
+					id := code.Value("id").String().Raw()
+					checkCode(code, firstCodeListID, firstCodeListSecondEdition, id, labels[id])
+				}
 			})
 		})
 	})
@@ -44,13 +65,29 @@ func TestSuccessfullyGetAListOfAllCodesWithinCodeList(t *testing.T) {
 func TestFailureToGetAListOfAllCodesWithinCodeList(t *testing.T) {
 	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
 
-	// TODO Dont skip test once endpoint has been refactored
-	SkipConvey("Given a code list exists", t, func() {
+	Convey("Given a code list exists", t, func() {
 		Convey("When you pass a code list that does not exist", func() {
 			Convey("Then the response should be status not found (404)", func() {
-				codeListAPI.GET("/code-lists/{id}/codes", invalidCodeListID).
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes", invalidCodeListID, firstCodeListEdition).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you pass an edition that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}/codes", firstCodeListID, invalidEdition).
 					Expect().Status(http.StatusNotFound)
 			})
 		})
 	})
 }
+
+func checkCode(code *httpexpect.Object, codeListID, edition, id, label string) {
+	code.Value("id").Equal(id)
+	code.Value("label").Equal(label)
+
+	links := code.Value("links").Object()
+	links.Value("code_list").Object().Value("href").String().Match("(.+)/code-lists/" + codeListID + "$")
+	links.Value("self").Object().Value("href").String().Match("(.+)/code-lists/" + codeListID + "/editions/" + edition + "/codes/" + id + "$")
+	links.Value("datasets").Object().Value("href").String().Match("(.+)/code-lists/" + codeListID + "/editions/" + edition + "/codes/" + id + "/datasets$")
+}
@@ -0,0 +1,100 @@
+package codeListAPI
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSuccessfullyGetEditionsOfACodeList(t *testing.T) {
+
+	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
+
+	Convey("Given a code list with more than one edition exists", t, func() {
+		Convey("When you request the editions of the code list", func() {
+			Convey("Then every edition of the code list should appear", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}/editions", firstCodeListID).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("items").Array().Length().Equal(2)
+				response.Path("$.items[*].edition").Array().ContainsOnly(firstCodeListEdition, firstCodeListSecondEdition)
+
+				for _, item := range response.Value("items").Array().Iter() {
+					checkEdition(item.Object(), firstCodeListID, item.Object().Value("edition").String().Raw(), firstCodeListLabel)
+				}
+			})
+		})
+
+		Convey("When you request a single edition of the code list", func() {
+			Convey("Then the edition should appear with links to its codes", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}/editions/{edition}", firstCodeListID, firstCodeListSecondEdition).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				checkEdition(response, firstCodeListID, firstCodeListSecondEdition, firstCodeListLabel)
+			})
+		})
+	})
+
+	Convey("Given a code list with a single edition exists", t, func() {
+		Convey("When you request the editions of the code list", func() {
+			Convey("Then the single edition should appear", func() {
+
+				response := codeListAPI.GET("/code-lists/{id}/editions", secondCodeListID).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("items").Array().Length().Equal(1)
+				checkEdition(response.Value("items").Array().First().Object(), secondCodeListID, secondCodeListEdition, secondCodeListLabel)
+			})
+		})
+	})
+}
+
+func TestFailureToGetEditionsOfACodeList(t *testing.T) {
+
+	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
+
+	Convey("Given a code list exists", t, func() {
+		Convey("When you request the editions of a code list that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions", invalidCodeListID).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you request an edition of a code list that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}", invalidCodeListID, firstCodeListEdition).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you request an edition that does not exist", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}", firstCodeListID, invalidEdition).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+
+		Convey("When you request an edition that only exists for another code list", func() {
+			Convey("Then the response should be status not found (404)", func() {
+				codeListAPI.GET("/code-lists/{id}/editions/{edition}", secondCodeListID, firstCodeListSecondEdition).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+	})
+}
+
+func checkEdition(edition *httpexpect.Object, codeListID, name, label string) {
+	edition.Value("edition").Equal(name)
+	edition.Value("label").Equal(label)
+
+	links := edition.Value("links").Object()
+	links.Value("self").Object().Value("id").Equal(name)
+	links.Value("self").Object().Value("href").String().Match("(.+)/code-lists/" + codeListID + "/editions/" + name + "$")
+	links.Value("editions").Object().Value("href").String().Match("(.+)/code-lists/" + codeListID + "/editions$")
+	links.Value("codes").Object().Value("href").String().Match("(.+)/code-lists/" + codeListID + "/editions/" + name + "/codes$")
+}
@@ -6,15 +6,14 @@ import (
 	"github.com/ONSdigital/dp-api-tests/config"
 	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
 	"github.com/ONSdigital/go-ns/log"
+	uuid "github.com/satori/go.uuid"
 )
 
 var cfg *config.Config
 
 const (
-	collection = "test"
-
-	firstCodeListID           = "cpih1dim1aggid"
 	firstCodeListEdition      = "one-off"
+	firstCodeListLabel        = "aggregate"
 	firstCodeListFirstCodeID  = "cpih1dim1S90401"
 	firstCodeListFirstLabel   = "09.4.1 Recreational and sporting services"
 	firstCodeListSecondCodeID = "cpih1dim1S90501"
@@ -22,15 +21,53 @@ const (
 	firstCodeListThirdCodeID  = "cpih1dim1S90402"
 	firstCodeListThirdLabel   = "09.4.2 Cultural services"
 
-	secondCodeListID          = "uk-only"
+	// the second edition relabels the first code, drops the third and adds a new one
+	firstCodeListSecondEdition      = "2019"
+	firstCodeListSecondEditionLabel = "09.4.1 Recreational services"
+	firstCodeListNewCodeID          = "cpih1dim1S90601"
+	firstCodeListNewCodeLabel       = "09.6.1 Package holidays"
+
+	secondCodeListEdition = "one-off"
+	secondCodeListLabel   = "geography"
+	secondCodeListCodeID  = "K02000001"
+	secondCodeListCode    = "United Kingdom"
+
+	datasetEdition = "2018"
 
 	invalidCodeListID = "1C3221283FD544F0BBAD619779D8960E"
-	firstCode         = "cpih1dim1S90401"
-	secondCode        = "cpih1dim1S90501"
-	thirdCode         = "cpih1dim1S90402"
+	invalidEdition    = "9999"
 	invalidCode       = "AC!@£$)98"
 )
 
+// code list and dataset ids are unique to each run so the suite only ever
+// reads the code lists it has seeded
+var (
+	runID = uuid.NewV4().String()[:8]
+
+	firstCodeListID  = "dp-api-tests-" + runID + "-cpih1dim1aggid"
+	secondCodeListID = "dp-api-tests-" + runID + "-uk-only"
+
+	publishedDatasetID      = "dp-api-tests-" + runID + "-cpih01"
+	unpublishedDatasetID    = "dp-api-tests-" + runID + "-unpublished"
+	secondEditionDatasetID  = "dp-api-tests-" + runID + "-cpih02"
+	publishedInstanceID     = uuid.NewV4().String()
+	unpublishedInstanceID   = uuid.NewV4().String()
+	secondEditionInstanceID = uuid.NewV4().String()
+
+	// searchToken is in the label of every code list seeded for search, and
+	// nowhere else, so searching for it only returns the seeded code lists
+	searchToken = "dpapitests" + runID
+
+	localAuthorityCodeListID   = "dp-api-tests-" + runID + "-search-local-authority"
+	countryCodeListID          = "dp-api-tests-" + runID + "-search-country"
+	monthCodeListID            = "dp-api-tests-" + runID + "-search-month"
+	specialAggregateCodeListID = "dp-api-tests-" + runID + "-search-special-aggregate"
+
+	localAuthorityLabel    = "Local authority districts " + searchToken
+	localAuthorityCode     = runID + "E06000001"
+	localAuthorityCodeText = "Hartlepool" + runID
+)
+
 func init() {
 	var err error
 	cfg, err = config.Get()
@@ -39,17 +76,132 @@ func init() {
 		os.Exit(1)
 	}
 
-	store, err := neo4j.NewDatastore(cfg.Neo4jAddr, "", neo4j.GenericHierarchyCPIHTestData)
-	if err != nil {
-		log.ErrorC("neo4j datastore error", err, nil)
-		os.Exit(1)
+	log.Debug("config is:", log.Data{"config": cfg})
+}
+
+// codeLists returns the code lists seeded for the suite
+func codeLists() []*neo4j.CodeList {
+	return []*neo4j.CodeList{
+		{
+			ID: firstCodeListID,
+			Editions: []*neo4j.CodeListEdition{
+				{
+					Edition: firstCodeListEdition,
+					Label:   firstCodeListLabel,
+					Codes: []*neo4j.Code{
+						{Value: firstCodeListFirstCodeID, Label: firstCodeListFirstLabel},
+						{Value: firstCodeListSecondCodeID, Label: firstCodeListSecondLabel},
+						{Value: firstCodeListThirdCodeID, Label: firstCodeListThirdLabel},
+					},
+				},
+				{
+					Edition: firstCodeListSecondEdition,
+					Label:   firstCodeListLabel,
+					Codes: []*neo4j.Code{
+						{Value: firstCodeListFirstCodeID, Label: firstCodeListSecondEditionLabel},
+						{Value: firstCodeListSecondCodeID, Label: firstCodeListSecondLabel},
+						{Value: firstCodeListNewCodeID, Label: firstCodeListNewCodeLabel},
+					},
+				},
+			},
+		},
+		{
+			ID: secondCodeListID,
+			Editions: []*neo4j.CodeListEdition{
+				{
+					Edition: secondCodeListEdition,
+					Label:   secondCodeListLabel,
+					Codes: []*neo4j.Code{
+						{Value: secondCodeListCodeID, Label: secondCodeListCode},
+					},
+				},
+			},
+		},
 	}
+}
 
-	err = store.CreateCPIHCodeList()
-	if err != nil {
-		log.ErrorC("neo4j datastore error", err, nil)
-		os.Exit(1)
+// codeListDatasets returns the datasets seeded as using codes from the code lists
+func codeListDatasets() []*neo4j.CodeListDataset {
+	return []*neo4j.CodeListDataset{
+		{
+			InstanceID:      publishedInstanceID,
+			DatasetID:       publishedDatasetID,
+			Edition:         datasetEdition,
+			Version:         1,
+			Published:       true,
+			CodeListID:      firstCodeListID,
+			CodeListEdition: firstCodeListEdition,
+			Codes:           []string{firstCodeListFirstCodeID, firstCodeListSecondCodeID},
+		},
+		{
+			InstanceID:      unpublishedInstanceID,
+			DatasetID:       unpublishedDatasetID,
+			Edition:         datasetEdition,
+			Version:         1,
+			Published:       false,
+			CodeListID:      firstCodeListID,
+			CodeListEdition: firstCodeListEdition,
+			Codes:           []string{firstCodeListFirstCodeID},
+		},
+		{
+			InstanceID:      secondEditionInstanceID,
+			DatasetID:       secondEditionDatasetID,
+			Edition:         datasetEdition,
+			Version:         1,
+			Published:       true,
+			CodeListID:      firstCodeListID,
+			CodeListEdition: firstCodeListSecondEdition,
+			Codes:           []string{firstCodeListNewCodeID},
+		},
 	}
+}
 
-	log.Debug("config is:", log.Data{"config": cfg})
+// searchCodeLists returns the code lists seeded for code list search, two of
+// which are geography code lists
+func searchCodeLists() []*neo4j.CodeList {
+	return []*neo4j.CodeList{
+		{
+			ID:   localAuthorityCodeListID,
+			Type: "geography",
+			Editions: []*neo4j.CodeListEdition{
+				{
+					Edition: "2017",
+					Label:   localAuthorityLabel,
+					Codes:   []*neo4j.Code{{Value: localAuthorityCode, Label: localAuthorityCodeText}},
+				},
+			},
+		},
+		{
+			ID:   countryCodeListID,
+			Type: "geography",
+			Editions: []*neo4j.CodeListEdition{
+				{
+					Edition: "one-off",
+					Label:   "Countries " + searchToken,
+					Codes:   []*neo4j.Code{{Value: runID + "K02000001", Label: "United Kingdom"}},
+				},
+			},
+		},
+		{
+			ID:   monthCodeListID,
+			Type: "time",
+			Editions: []*neo4j.CodeListEdition{
+				{
+					Edition: "one-off",
+					Label:   "Calendar months " + searchToken,
+					Codes:   []*neo4j.Code{{Value: runID + "Jan-18", Label: "January 2018"}},
+				},
+			},
+		},
+		{
+			ID: specialAggregateCodeListID,
+			Editions: []*neo4j.CodeListEdition{
+				{
+					Edition: "one-off",
+					Label:   "Special aggregates " + searchToken,
+					Codes:   []*neo4j.Code{{Value: runID + "SA01", Label: "Special aggregate one"}},
+				},
+			},
+		},
+	}
 }
@@ -0,0 +1,43 @@
+package codeListAPI
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// TestMain seeds the code lists used by every test in the suite, removes them
+// once the suite has run and fails the suite if any test data is left behind
+func TestMain(m *testing.M) {
+	fixture, err := neo4j.NewCodeListFixture(append(codeLists(), searchCodeLists()...), codeListDatasets()...)
+	if err != nil {
+		log.ErrorC("Unable to build code list fixture", err, nil)
+		os.Exit(1)
+	}
+
+	datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, "", "")
+	if err != nil {
+		log.ErrorC("neo4j datastore error", err, nil)
+		os.Exit(1)
+	}
+
+	if _, err = datastore.SetupFixture(fixture); err != nil {
+		log.ErrorC("Unable to setup code list test data", err, nil)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	if _, err = datastore.TeardownCreated(false); err != nil {
+		log.ErrorC("Unable to tear down code list test data", err, nil)
+		code = 1
+	}
+
+	if err = datastore.Close(); err != nil {
+		log.ErrorC("neo4j datastore error", err, nil)
+	}
+
+	os.Exit(neo4j.CheckResidue(cfg.Neo4jAddr, code))
+}
@@ -1,4 +1,237 @@
 package codeListAPI
 
-// POST -  /search/code-lists
-// This end point is not implemented yet.
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// searchCodeListIDs are the ids of every code list with searchToken in its label
+var searchCodeListIDs = []interface{}{localAuthorityCodeListID, countryCodeListID, monthCodeListID, specialAggregateCodeListID}
+
+func TestSuccessfullySearchCodeLists(t *testing.T) {
+
+	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
+	skipUntilSearchImplemented(t, codeListAPI)
+
+	Convey("Given a set of code lists exist", t, func() {
+		Convey("When you search for a word in the label of a code list", func() {
+			Convey("Then only that code list should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": "districts " + searchToken}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(1)
+
+				items := response.Value("items").Array()
+				items.Length().Equal(1)
+				items.First().Object().Value("links").Object().Value("self").Object().Value("id").Equal(localAuthorityCodeListID)
+				items.First().Object().Value("label").Equal(localAuthorityLabel)
+				items.First().Object().Value("links").Object().Value("self").Object().Value("href").String().
+					Match("(.+)/code-lists/" + localAuthorityCodeListID + "$")
+				items.First().Object().Value("links").Object().Value("editions").Object().Value("href").String().
+					Match("(.+)/code-lists/" + localAuthorityCodeListID + "/editions$")
+			})
+		})
+
+		Convey("When you search for a code within a code list", func() {
+			Convey("Then the code list using that code should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": localAuthorityCode}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(1)
+				response.Path("$.items[*].links.self.id").Array().ContainsOnly(localAuthorityCodeListID)
+			})
+		})
+
+		Convey("When you search for the label of a code within a code list", func() {
+			Convey("Then the code list using that code should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": localAuthorityCodeText}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(1)
+				response.Path("$.items[*].links.self.id").Array().ContainsOnly(localAuthorityCodeListID)
+			})
+		})
+
+		Convey("When you search for a word in the label of every code list and filter by dimension type", func() {
+			Convey("Then only code lists for that type of dimension should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken, "type": "geography"}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(2)
+				response.Value("total_count").Equal(2)
+				response.Path("$.items[*].links.self.id").Array().ContainsOnly(localAuthorityCodeListID, countryCodeListID)
+			})
+		})
+
+		Convey("When you search for a word in the label of every code list", func() {
+			Convey("Then every code list should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(len(searchCodeListIDs))
+				response.Value("total_count").Equal(len(searchCodeListIDs))
+				response.Path("$.items[*].links.self.id").Array().ContainsOnly(searchCodeListIDs...)
+			})
+		})
+
+		Convey("When you search for a word that is not in any code list", func() {
+			Convey("Then an empty list of code lists should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": uuid.NewV4().String()}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(0)
+				response.Value("total_count").Equal(0)
+				response.Value("items").Array().Empty()
+			})
+		})
+
+		Convey("When you filter by a dimension type no code list matching the search has", func() {
+			Convey("Then an empty list of code lists should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": localAuthorityCode, "type": "time"}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(0)
+				response.Value("items").Array().Empty()
+			})
+		})
+	})
+}
+
+func TestSuccessfullyPageThroughCodeListSearchResults(t *testing.T) {
+
+	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
+	skipUntilSearchImplemented(t, codeListAPI)
+
+	Convey("Given a search matches a set of code lists", t, func() {
+
+		all := postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken}).
+			Expect().Status(http.StatusOK).JSON().Object().
+			Path("$.items[*].links.self.id").Array().Raw()
+
+		Convey("When the same search is made again", func() {
+			Convey("Then the code lists should appear in the same order", func() {
+
+				postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken}).
+					Expect().Status(http.StatusOK).JSON().Object().
+					Path("$.items[*].links.self.id").Array().Equal(all)
+			})
+		})
+
+		Convey("When you page through the results two at a time", func() {
+			Convey("Then each page should hold the next code lists in the same order as a single page", func() {
+
+				var paged []interface{}
+				for offset := 0; offset < len(searchCodeListIDs); offset += 2 {
+					response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken, "limit": 2, "offset": offset}).
+						Expect().Status(http.StatusOK).JSON().Object()
+
+					response.Value("limit").Equal(2)
+					response.Value("offset").Equal(offset)
+					response.Value("count").Equal(2)
+					response.Value("total_count").Equal(len(searchCodeListIDs))
+
+					paged = append(paged, response.Path("$.items[*].links.self.id").Array().Raw()...)
+				}
+
+				So(paged, ShouldResemble, all)
+			})
+		})
+
+		Convey("When you request a page beyond the last result", func() {
+			Convey("Then an empty page should appear with the total number of results", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken, "limit": 2, "offset": len(searchCodeListIDs)}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(0)
+				response.Value("total_count").Equal(len(searchCodeListIDs))
+				response.Value("items").Array().Empty()
+			})
+		})
+
+		Convey("When you request a limit of one", func() {
+			Convey("Then only the first code list should appear", func() {
+
+				response := postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken, "limit": 1}).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(1)
+				response.Path("$.items[*].links.self.id").Array().Equal(all[:1])
+			})
+		})
+	})
+}
+
+func TestFailureToSearchCodeLists(t *testing.T) {
+
+	codeListAPI := httpexpect.New(t, cfg.CodeListAPIURL)
+	skipUntilSearchImplemented(t, codeListAPI)
+
+	Convey("Given a set of code lists exist", t, func() {
+		Convey("When you search without a search term", func() {
+			Convey("Then the response should be status bad request (400)", func() {
+				postCodeListSearch(codeListAPI, map[string]interface{}{"type": "geography"}).
+					Expect().Status(http.StatusBadRequest)
+			})
+		})
+
+		Convey("When you search with an empty search term", func() {
+			Convey("Then the response should be status bad request (400)", func() {
+				postCodeListSearch(codeListAPI, map[string]interface{}{"q": ""}).
+					Expect().Status(http.StatusBadRequest)
+			})
+		})
+
+		Convey("When you search with a body that is not valid json", func() {
+			Convey("Then the response should be status bad request (400)", func() {
+				codeListAPI.POST("/search/code-lists").WithBytes([]byte(`{"q": "`)).
+					Expect().Status(http.StatusBadRequest)
+			})
+		})
+
+		Convey("When you search with a negative limit", func() {
+			Convey("Then the response should be status bad request (400)", func() {
+				postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken, "limit": -1}).
+					Expect().Status(http.StatusBadRequest)
+			})
+		})
+
+		Convey("When you search with a negative offset", func() {
+			Convey("Then the response should be status bad request (400)", func() {
+				postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken, "offset": -1}).
+					Expect().Status(http.StatusBadRequest)
+			})
+		})
+
+		Convey("When you search with a limit that is not a number", func() {
+			Convey("Then the response should be status bad request (400)", func() {
+				postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken, "limit": "ten"}).
+					Expect().Status(http.StatusBadRequest)
+			})
+		})
+	})
+}
+
+func postCodeListSearch(codeListAPI *httpexpect.Expect, body map[string]interface{}) *httpexpect.Request {
+	return codeListAPI.POST("/search/code-lists").WithJSON(body)
+}
+
+// skipUntilSearchImplemented skips a test while the code list API does not
+// route requests for code list search
+func skipUntilSearchImplemented(t *testing.T, codeListAPI *httpexpect.Expect) {
+	status := postCodeListSearch(codeListAPI, map[string]interface{}{"q": searchToken}).Expect().Raw().StatusCode
+	if status == http.StatusNotFound || status == http.StatusMethodNotAllowed {
+		t.Skipf("code list search is not implemented, POST /search/code-lists returned %d", status)
+	}
+}
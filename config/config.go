@@ -11,13 +11,16 @@ type Config struct {
 	FilterAPIURL              string   `envconfig:"FILTER_API_URL"`
 	HierarchyAPIURL           string   `envconfig:"HIERARCHY_API_URL"`
 	ImportAPIURL              string   `envconfig:"IMPORT_API_URL"`
+	ImportAPIFakeRecipesURL   string   `envconfig:"IMPORT_API_FAKE_RECIPES_URL"`
 	RecipeAPIURL              string   `envconfig:"RECIPE_API_URL"`
+	FakeRecipeAPIBindAddr     string   `envconfig:"FAKE_RECIPE_API_BIND_ADDR"`
 	SearchAPIURL              string   `envconfig:"SEARCH_API_URL"`
 	ElasticSearchAPIURL       string   `envconfig:"ELASTIC_SEARCH_URL"`
 	MongoAddr                 string   `envconfig:"MONGODB_BIND_ADDR"`
 	MongoDB                   string   `envconfig:"MONGODB_DATABASE"`
 	MongoFiltersDB            string   `envconfig:"MONGODB_FILTERS_DATABASE"`
 	MongoImportsDB            string   `envconfig:"MONGODB_IMPORTS_DATABASE"`
+	MongoChangeStreams        bool     `envconfig:"MONGODB_CHANGE_STREAMS"`
 	Neo4jAddr                 string   `envconfig:"NEO4J_BIND_ADDR"`
 	Brokers                   []string `envconfig:"KAFKA_ADDR"`
 	ObservationsInsertedTopic string   `envconfig:"IMPORT_OBSERVATIONS_INSERTED_TOPIC"`
@@ -27,6 +30,9 @@ type Config struct {
 	VaultAddress              string   `envconfig:"VAULT_ADDR"`
 	VaultToken                string   `envconfig:"VAULT_TOKEN"`
 	VaultPath                 string   `envconfig:"VAULT_PATH"`
+	EndToEndStartStage        string   `envconfig:"E2E_START_STAGE"`
+	EndToEndInstanceID        string   `envconfig:"E2E_INSTANCE_ID"`
+	FilterScenarioSeed        int64    `envconfig:"FILTER_SCENARIO_SEED"`
 }
 
 var cfg *Config
@@ -42,13 +48,16 @@ func Get() (*Config, error) {
 		FilterAPIURL:              "http://localhost:22100",
 		HierarchyAPIURL:           "http://localhost:22600",
 		ImportAPIURL:              "http://localhost:21800",
+		ImportAPIFakeRecipesURL:   "",
 		RecipeAPIURL:              "http://localhost:22300",
+		FakeRecipeAPIBindAddr:     ":22399",
 		SearchAPIURL:              "http://localhost:23100",
 		ElasticSearchAPIURL:       "http://localhost:9200",
 		MongoAddr:                 "localhost:27017",
 		MongoDB:                   "test",
 		MongoImportsDB:            "test",
 		MongoFiltersDB:            "test",
+		MongoChangeStreams:        false,
 		Neo4jAddr:                 "bolt://localhost:7687",
 		Brokers:                   []string{"localhost:9092"},
 		ObservationsInsertedTopic: "import-observations-inserted",
@@ -58,6 +67,9 @@ func Get() (*Config, error) {
 		VaultAddress:              "http://localhost:8200",
 		VaultToken:                "",
 		VaultPath:                 "secret/shared/psk",
+		EndToEndStartStage:        "",
+		EndToEndInstanceID:        "",
+		FilterScenarioSeed:        1,
 	}
 
 	return cfg, envconfig.Process("", cfg)
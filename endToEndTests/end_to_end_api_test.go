@@ -1,12 +1,14 @@
 package generateFiles
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
-	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"os"
+	"regexp"
 	"strconv"
 	"testing"
 	"time"
@@ -17,788 +19,996 @@ import (
 
 	"net/url"
 
+	"github.com/ONSdigital/dp-api-tests/helpers/consistency"
+	"github.com/ONSdigital/dp-api-tests/helpers/downloads"
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
 	"github.com/ONSdigital/dp-api-tests/testDataSetup/elasticsearch"
 	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
 	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+	neo4jassertions "github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j/assertions"
 	"github.com/ONSdigital/go-ns/log"
 	"github.com/ONSdigital/go-ns/rchttp"
 )
 
-var timeout = time.Duration(30 * time.Second)
+// importStages take a v4 file from upload through to a published, filterable
+// version, in the order they run
+var importStages = []Stage{
+	{Name: "upload", Run: uploadV4File},
+	{Name: "import", Timeout: importTimeout, Run: importV4File},
+	{Name: "observations", Timeout: observationsTimeout, Run: checkObservationsImported},
+	{Name: "hierarchies", Run: checkHierarchiesBuilt},
+	{Name: "search-index", Run: checkSearchIndexBuilt},
+	{Name: "consistency", Run: checkStoresConsistent},
+	{Name: "edition-confirm", Run: confirmEdition},
+	{Name: "associate", Run: associateVersion},
+	{Name: "export", Timeout: exportTimeout, Run: checkFullDownloads},
+	{Name: "pre-publish-filter", Run: checkPrePublishFiltering},
+	{Name: "publish", Timeout: publishTimeout, Run: publishVersion},
+	{Name: "search", Run: checkPublishedSearch},
+	{Name: "download-content", Run: checkDownloadContent},
+	{Name: "filter", Run: checkPublishedFiltering},
+	{Name: "filter-content", Run: checkFilterContent},
+}
+
+// endToEndStages are the stages of the end to end test. Teardown is the last
+// stage, so a failed run leaves everything in place to be resumed from the
+// failed stage, or torn down by starting from teardown.
+var endToEndStages = append(importStages[:len(importStages):len(importStages)], Stage{Name: "teardown", Run: teardownEndToEnd})
+
+// cpihObservation is an observation of the v4 test file checked before and
+// after the version is published
+var cpihObservation = &Observation{
+	Options: map[string]string{"time": "Apr-05", "geography": "K02000001", "aggregate": "cpih1dim1G50100"},
+	Value:   "81.7",
+}
+
+// leftTestData is set when the end to end test fails and leaves its test data
+// in place, which later tests importing the same dataset would trip over
+var leftTestData bool
 
 func TestSuccessfulEndToEndProcess(t *testing.T) {
 
-	importAPI := httpexpect.New(t, cfg.ImportAPIURL)
 	recipeAPI := httpexpect.New(t, cfg.RecipeAPIURL)
-	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
-	filterAPI := httpexpect.New(t, cfg.FilterAPIURL)
-	hierarchyAPI := httpexpect.New(t, cfg.HierarchyAPIURL)
-	searchAPI := httpexpect.New(t, cfg.SearchAPIURL)
 
-	hasRemovedAllResources := true
-	filename := "v4TestFile.csv"
-	recipe := "2943f3c5-c3f1-4a9a-aa6e-14d21c33524c"
+	p := &Pipeline{
+		Filename:    v4TestFile,
+		Recipe:      cpihRecipe,
+		Observation: cpihObservation,
+		Edition:     "2017",
+		Version:     1,
+		NewDataset:  true,
+		InstanceID:  cfg.EndToEndInstanceID,
+	}
+
+	// Expected observations, headers and dimension options come from the v4 file
+	var err error
+	p.V4File, err = v4.ParseFile(p.Filename)
+	if err != nil {
+		log.ErrorC("failed to parse v4 file, discontinue with test", err, log.Data{"v4_file": p.Filename})
+		t.FailNow()
+	}
 
 	// Get dataset ID from recipe API
-	recipeResponse := recipeAPI.GET("/recipes/{recipe}", recipe).
+	recipeResponse := recipeAPI.GET("/recipes/{recipe}", p.Recipe).
 		Expect().Status(http.StatusOK).JSON().Object()
 
 	recipeResponse.Value("id").NotNull()
 
-	Convey("Given a v4 file exists in aws", t, func() {
-		// Send v4 file to aws
-		err := sendV4FileToAWS(region, bucketName, filename, true)
-		if err != nil {
-			log.ErrorC("failed to load in v4 to aws, discontinue with test", err, nil)
-			t.FailNow()
-		}
+	leftTestData = !p.Run(t, endToEndStages, cfg.EndToEndStartStage)
+}
 
-		// import API expects a s3 url as the location of the file
-		location := "s3://" + bucketName + "/" + filename
+func uploadV4File(t *testing.T, p *Pipeline) {
+	// Send v4 file to aws
+	err := sendV4FileToAWS(region, bucketName, p.Filename, true)
+	if err != nil {
+		log.ErrorC("failed to load in v4 to aws, discontinue with test", err, nil)
+		t.FailNow()
+	}
 
-		log.Info("Create job with state created", nil)
-		postJobResponse := importAPI.POST("/jobs").WithBytes([]byte(createValidJobJSON(recipe, location))).
-			WithHeaders(headers).Expect().Status(http.StatusCreated).JSON().Object()
+	p.Artifact("location", "s3://"+bucketName+"/"+p.Filename)
+}
 
-		postJobResponse.Value("id").NotNull()
-		jobID := postJobResponse.Value("id").String().Raw()
+func importV4File(t *testing.T, p *Pipeline) {
+	importAPI := httpexpect.New(t, cfg.ImportAPIURL)
+	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
 
-		postJobResponse.Value("files").Array().Element(0).Object().Value("alias_name").Equal("CPIH")
-		postJobResponse.Value("files").Array().Element(0).Object().Value("url").Equal("s3://ons-dp-cmd-test/v4TestFile.csv")
+	// import API expects a s3 url as the location of the file
+	location := "s3://" + bucketName + "/" + p.Filename
 
-		postJobResponse.Value("last_updated").NotNull()
-		postJobResponse.Value("links").Object().Value("instances").Array().Element(0).Object().Value("id").NotNull()
-		postJobResponse.Value("links").Object().Value("self").Object().Value("href").String().Match(cfg.ImportAPIURL + "/jobs/" + jobID + "$")
-		postJobResponse.Value("recipe").Equal(recipe)
-		postJobResponse.Value("state").Equal("created")
+	log.Info("Create job with state created", nil)
+	postJobResponse := importAPI.POST("/jobs").WithBytes([]byte(createValidJobJSON(p.Recipe, location))).
+		WithHeaders(headers).Expect().Status(http.StatusCreated).JSON().Object()
 
-		instanceID := postJobResponse.Value("links").Object().Value("instances").Array().Element(0).Object().Value("id").String().Raw()
+	postJobResponse.Value("id").NotNull()
+	p.JobID = postJobResponse.Value("id").String().Raw()
+	p.Artifact("job_id", p.JobID)
 
-		// Check for instance creation
-		instanceResource, err := mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve instance resource", err, log.Data{"instance_id": instanceID})
-			t.FailNow()
-		}
+	postJobResponse.Value("files").Array().Element(0).Object().Value("alias_name").Equal("CPIH")
+	postJobResponse.Value("files").Array().Element(0).Object().Value("url").Equal(location)
+
+	postJobResponse.Value("last_updated").NotNull()
+	postJobResponse.Value("links").Object().Value("instances").Array().Element(0).Object().Value("id").NotNull()
+	postJobResponse.Value("links").Object().Value("self").Object().Value("href").String().Match(cfg.ImportAPIURL + "/jobs/" + p.JobID + "$")
+	postJobResponse.Value("recipe").Equal(p.Recipe)
+	postJobResponse.Value("state").Equal("created")
+
+	p.InstanceID = postJobResponse.Value("links").Object().Value("instances").Array().Element(0).Object().Value("id").String().Raw()
+	p.Artifact("instance_id", p.InstanceID)
 
-		So(len(instanceResource.Dimensions), ShouldEqual, 3)
-		So(instanceResource.Links.Job.ID, ShouldEqual, jobID)
-		So(instanceResource.Links.Job.HRef, ShouldEqual, cfg.ImportAPIURL+"/jobs/"+jobID)
-		So(instanceResource.Links.Dataset.ID, ShouldEqual, datasetName)
-		So(instanceResource.Links.Dataset.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName)
-		So(instanceResource.Links.Self.HRef, ShouldEqual, cfg.DatasetAPIURL+"/instances/"+instanceID)
-		So(instanceResource.State, ShouldEqual, "created")
+	// Check for instance creation
+	instanceResource := p.GetInstance(t)
 
+	So(len(instanceResource.Dimensions), ShouldEqual, len(p.V4File.Dimensions))
+	So(instanceResource.Links.Job.ID, ShouldEqual, p.JobID)
+	So(instanceResource.Links.Job.HRef, ShouldEqual, cfg.ImportAPIURL+"/jobs/"+p.JobID)
+	So(instanceResource.Links.Dataset.ID, ShouldEqual, datasetName)
+	So(instanceResource.Links.Dataset.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName)
+	So(instanceResource.Links.Self.HRef, ShouldEqual, cfg.DatasetAPIURL+"/instances/"+p.InstanceID)
+	So(instanceResource.State, ShouldEqual, "created")
+
+	if p.NewDataset {
 		log.Info("Create dataset with dataset id from previous response", nil)
 		postDatasetResponse := datasetAPI.POST("/datasets/{id}", datasetName).WithHeaders(headers).WithBytes([]byte(validPOSTCreateDatasetJSON)).
 			Expect().Status(http.StatusCreated).JSON().Object()
 
 		postDatasetResponse.Value("next").Object().Value("links").Object().Value("self").Object().Value("href").String().Match(cfg.DatasetAPIURL + "/datasets/" + datasetName + "$")
 		postDatasetResponse.Value("next").Object().Value("state").Equal("created")
+	}
 
-		log.Info("Update job state to submitted", nil)
-		importAPI.PUT("/jobs/{id}", jobID).WithHeaders(headers).WithBytes([]byte(`{"state":"submitted"}`)).
-			Expect().Status(http.StatusOK)
+	log.Info("Update job state to submitted", nil)
+	importAPI.PUT("/jobs/{id}", p.JobID).WithHeaders(headers).WithBytes([]byte(`{"state":"submitted"}`)).
+		Expect().Status(http.StatusOK)
 
-		// Check import job state is completed or submitted
-		jobResource, err := mongo.GetJob(cfg.MongoImportsDB, "imports", "id", jobID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve job resource", err, log.Data{"job_id": jobID})
-			t.FailNow()
-		}
+	// Check import job state is completed or submitted
+	jobResource, err := mongo.GetJob(cfg.MongoImportsDB, "imports", "id", p.JobID)
+	if err != nil {
+		log.ErrorC("Unable to retrieve job resource", err, log.Data{"job_id": p.JobID})
+		t.FailNow()
+	}
 
-		So(jobResource.State, ShouldNotEqual, "created")
+	So(jobResource.State, ShouldNotEqual, "created")
 
-		var stateHasChanged bool
-		if jobResource.State == "completed" || jobResource.State == "submitted" {
-			stateHasChanged = true
-		}
+	var stateHasChanged bool
+	if jobResource.State == "completed" || jobResource.State == "submitted" {
+		stateHasChanged = true
+	}
 
-		So(stateHasChanged, ShouldEqual, true)
-
-		// Check instance has updated with headers, state is completed, total_observations and total_inserted_observations
-		totalObservations := int64(1510)
-
-		tryAgain := true
-
-		exitObservationsCompleteLoop := make(chan bool)
-
-		go func() {
-			time.Sleep(timeout)
-			close(exitObservationsCompleteLoop)
-		}()
-
-	observationsCompleteLoop:
-		for tryAgain {
-			select {
-			case <-exitObservationsCompleteLoop:
-				break observationsCompleteLoop
-			default:
-				instanceResource, err = mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-				if err != nil {
-					log.ErrorC("Unable to retrieve instance document", err, log.Data{"instance_id": instanceID})
-					t.FailNow()
-				}
-				if instanceResource.ImportTasks.ImportObservations.State == "completed" {
-					tryAgain = false
-				} else {
-					So(instanceResource.State, ShouldEqual, "submitted")
-					So(instanceResource.ImportTasks.ImportObservations.State, ShouldEqual, "created")
-					time.Sleep(time.Millisecond * 100) // Relax the continuous battering of mongo database
-				}
-			}
-		}
+	// an import expected to fail may already have done so
+	if p.ExpectFailure && jobResource.State == "failed" {
+		stateHasChanged = true
+	}
 
-		if tryAgain != false {
-			err = errors.New("timed out")
-			log.ErrorC("Timed out - failed to get instance document to a state of completed", err, log.Data{"instance_id": instanceID, "state": instanceResource.State, "timeout": timeout})
-			t.FailNow()
+	So(stateHasChanged, ShouldEqual, true)
+}
+
+func checkObservationsImported(t *testing.T, p *Pipeline) {
+	// Check instance has updated with headers, state is completed, total_observations and total_inserted_observations
+	totalObservations := int64(p.V4File.Observations)
+
+	instanceResource := p.WaitForInstance(t, "failed to get instance document to a state of completed", func(instanceResource mongo.Instance) bool {
+		if instanceResource.ImportTasks.ImportObservations.State == "completed" {
+			return true
 		}
 
-		So(instanceResource.Headers, ShouldResemble, &[]string{"V4_0", "mmm-yy", "time", "uk-only", "geography", "cpih1dim1aggid", "aggregate"})
 		So(instanceResource.State, ShouldEqual, "submitted")
-		So(instanceResource.ImportTasks.ImportObservations.State, ShouldEqual, "completed")
-		So(instanceResource.ImportTasks.ImportObservations.InsertedObservations, ShouldResemble, totalObservations)
-		So(instanceResource.TotalObservations, ShouldResemble, totalObservations)
+		So(instanceResource.ImportTasks.ImportObservations.State, ShouldEqual, "created")
+		return false
+	})
 
-		// Check dimension options
-		count, err := mongo.CountDimensionOptions(cfg.MongoDB, "dimension.options", "instance_id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve dimension option resources", err, log.Data{"instance_id": instanceID})
+	So(instanceResource.Headers, ShouldResemble, &p.V4File.Header.Row)
+	So(instanceResource.State, ShouldEqual, "submitted")
+	So(instanceResource.ImportTasks.ImportObservations.State, ShouldEqual, "completed")
+	So(instanceResource.ImportTasks.ImportObservations.InsertedObservations, ShouldResemble, totalObservations)
+	So(instanceResource.TotalObservations, ShouldResemble, totalObservations)
+
+	// Check dimension options
+	count, err := mongo.CountDimensionOptions(cfg.MongoDB, "dimension.options", "instance_id", p.InstanceID)
+	if err != nil {
+		log.ErrorC("Unable to retrieve dimension option resources", err, log.Data{"instance_id": p.InstanceID})
+		t.FailNow()
+	}
+
+	So(count, ShouldEqual, p.V4File.Options())
+	p.Artifact("dimension_options", strconv.Itoa(count))
+
+	// Check observations and dimension options have been written to neo4j
+	graph, err := neo4j.NewDatastore(cfg.Neo4jAddr, p.InstanceID, "")
+	if err != nil {
+		log.ErrorC("Failed to connect to neo4j database", err, nil)
+		t.FailNow()
+	}
+
+	optionCounts := make(map[string]int)
+	for _, d := range p.V4File.Dimensions {
+		optionCounts[d.Name] = len(d.Options)
+	}
+
+	neo4jassertions.ShouldHaveObservations(graph, p.InstanceID, totalObservations)
+	neo4jassertions.ShouldHaveDimensionOptionCounts(graph, p.InstanceID, optionCounts)
+
+	if err = graph.Close(); err != nil {
+		log.ErrorC("Failed to close connection to neo4j database", err, nil)
+	}
+}
+
+func checkHierarchiesBuilt(t *testing.T, p *Pipeline) {
+	hierarchyAPI := httpexpect.New(t, cfg.HierarchyAPIURL)
+
+	waitForHierarchies(t, p)
+
+	// Check hierarchies exist by calling the hierarchy api
+	getHierarchyParentDimensionResponse := hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension}", p.InstanceID, "aggregate").WithHeaders(headers).
+		Expect().Status(http.StatusOK).JSON().Object()
+
+	getHierarchyParentDimensionResponse.Value("has_data").Equal(true)
+	getHierarchyParentDimensionResponse.Value("label").Equal("Overall Index")
+	getHierarchyParentDimensionResponse.Value("no_of_children").Equal(12)
+	getHierarchyParentDimensionResponse.Value("links").Object().Value("code").Object().Value("href").Equal(cfg.CodeListAPIURL + "/code-lists/cpih1dim1aggid/codes/cpih1dim1A0")
+	getHierarchyParentDimensionResponse.Value("links").Object().Value("code").Object().Value("id").Equal("cpih1dim1A0")
+	getHierarchyParentDimensionResponse.Value("links").Object().Value("self").Object().Value("href").Equal(cfg.HierarchyAPIURL + "/hierarchies/" + p.InstanceID + "/aggregate")
+
+	numberOfChildren := getHierarchyParentDimensionResponse.Value("no_of_children").Raw()
+	getHierarchyParentDimensionResponse.Value("children").Array().Length().Equal(numberOfChildren)
+}
+
+// waitForHierarchies waits for the hierarchy builder to complete the build
+// hierarchy tasks of the instance
+func waitForHierarchies(t *testing.T, p *Pipeline) {
+	p.WaitForInstance(t, "failed to get instance document to have hierarchy tasks with states of completed", func(instanceResource mongo.Instance) bool {
+		if instanceResource.ImportTasks.BuildHierarchyTasks == nil ||
+			len(instanceResource.ImportTasks.BuildHierarchyTasks) < 1 {
+
+			log.ErrorC("no build hierarchy tasks found", nil, log.Data{"instance_id": p.InstanceID})
 			t.FailNow()
 		}
 
-		So(count, ShouldEqual, 156)
-
-		// Check hierarchies have been built
-		tryAgain = true
-
-		exitHierarchiesCompleteLoop := make(chan bool)
-
-		go func() {
-			time.Sleep(timeout)
-			close(exitHierarchiesCompleteLoop)
-		}()
-
-	hierarchiesCompleteLoop:
-		for tryAgain {
-			select {
-			case <-exitHierarchiesCompleteLoop:
-				break hierarchiesCompleteLoop
-			default:
-				instanceResource, err = mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-				if err != nil {
-					log.ErrorC("Unable to retrieve instance document", err, log.Data{"instance_id": instanceID})
-					t.FailNow()
-				}
-
-				if instanceResource.ImportTasks.BuildHierarchyTasks == nil ||
-					len(instanceResource.ImportTasks.BuildHierarchyTasks) < 1 {
-
-					log.ErrorC("no build hierarchy tasks found", err, log.Data{"instance_id": instanceID})
-					t.FailNow()
-				}
-
-				if instanceResource.ImportTasks.BuildHierarchyTasks[0].State == "completed" {
-					tryAgain = false
-				} else {
-					So(instanceResource.State, ShouldEqual, "submitted")
-					So(instanceResource.ImportTasks.BuildHierarchyTasks[0].State, ShouldEqual, "created")
-					time.Sleep(time.Millisecond * 100)
-				}
-			}
+		if instanceResource.ImportTasks.BuildHierarchyTasks[0].State == "completed" {
+			return true
 		}
 
-		if tryAgain != false {
-			err = errors.New("timed out")
-			log.ErrorC("Timed out - failed to get instance document to have hierarchy tasks with states of completed", err, log.Data{"instance_id": instanceID, "hierarchy_tasks": instanceResource.ImportTasks.BuildHierarchyTasks, "timeout": timeout})
+		So(instanceResource.State, ShouldEqual, "submitted")
+		So(instanceResource.ImportTasks.BuildHierarchyTasks[0].State, ShouldEqual, "created")
+		return false
+	})
+}
+
+func checkSearchIndexBuilt(t *testing.T, p *Pipeline) {
+	// Check elastic search tasks have completed against instance
+	p.WaitForInstance(t, "failed to get instance document to have search tasks with states of completed", func(instanceResource mongo.Instance) bool {
+		if instanceResource.ImportTasks.SearchTasks == nil ||
+			len(instanceResource.ImportTasks.SearchTasks) < 1 {
+
+			log.ErrorC("no build search tasks found", nil, log.Data{"instance_id": p.InstanceID})
 			t.FailNow()
 		}
 
-		// Check hierarchies exist by calling the hierarchy api
-		getHierarchyParentDimensionResponse := hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension}", instanceID, "aggregate").WithHeaders(headers).
-			Expect().Status(http.StatusOK).JSON().Object()
-
-		getHierarchyParentDimensionResponse.Value("has_data").Equal(true)
-		getHierarchyParentDimensionResponse.Value("label").Equal("Overall Index")
-		getHierarchyParentDimensionResponse.Value("no_of_children").Equal(12)
-		getHierarchyParentDimensionResponse.Value("links").Object().Value("code").Object().Value("href").Equal(cfg.CodeListAPIURL + "/code-lists/cpih1dim1aggid/codes/cpih1dim1A0")
-		getHierarchyParentDimensionResponse.Value("links").Object().Value("code").Object().Value("id").Equal("cpih1dim1A0")
-		getHierarchyParentDimensionResponse.Value("links").Object().Value("self").Object().Value("href").Equal(cfg.HierarchyAPIURL + "/hierarchies/" + instanceID + "/aggregate")
-
-		numberOfChildren := getHierarchyParentDimensionResponse.Value("no_of_children").Raw()
-		getHierarchyParentDimensionResponse.Value("children").Array().Length().Equal(numberOfChildren)
-
-		// Reset tryAgain for next loop
-		tryAgain = true
-
-		exitElasticSearchCompleteLoop := make(chan bool)
-
-		go func() {
-			time.Sleep(timeout)
-			close(exitElasticSearchCompleteLoop)
-		}()
-
-		// Check elastic search tasks have completed against instance
-	elasticSearchCompleteLoop:
-		for tryAgain {
-			select {
-			case <-exitElasticSearchCompleteLoop:
-				break elasticSearchCompleteLoop
-			default:
-				instanceResource, err = mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-				if err != nil {
-					log.ErrorC("Unable to retrieve instance document", err, log.Data{"instance_id": instanceID})
-					t.FailNow()
-				}
-
-				if instanceResource.ImportTasks.SearchTasks == nil ||
-					len(instanceResource.ImportTasks.SearchTasks) < 1 {
-
-					log.ErrorC("no build hierarchy tasks found", err, log.Data{"instance_id": instanceID})
-					t.FailNow()
-				}
-
-				if instanceResource.ImportTasks.SearchTasks[0].State == "completed" {
-					tryAgain = false
-				} else {
-					So(instanceResource.State, ShouldEqual, "submitted")
-					So(instanceResource.ImportTasks.SearchTasks[0].State, ShouldEqual, "created")
-					time.Sleep(time.Millisecond * 100)
-				}
-			}
+		if instanceResource.ImportTasks.SearchTasks[0].State == "completed" {
+			return true
 		}
 
-		if tryAgain != false {
-			err = errors.New("timed out")
-			log.ErrorC("Timed out - failed to get instance document to have search tasks with states of completed", err, log.Data{"instance_id": instanceID, "search_tasks": instanceResource.ImportTasks.SearchTasks, "timeout": timeout})
-			t.FailNow()
-		}
+		So(instanceResource.State, ShouldEqual, "submitted")
+		So(instanceResource.ImportTasks.SearchTasks[0].State, ShouldEqual, "created")
+		return false
+	})
 
-		// todo, add retry loop to check when the instance is set to complete.
-		time.Sleep(time.Second * 5)
+	// wait for the tracker to set the instance status to complete
+	instanceResource := p.WaitForInstance(t, "failed to get instance document to a state of completed", func(instanceResource mongo.Instance) bool {
+		return instanceResource.State == "completed"
+	})
 
-		// get the instance again now the tracker has had change to set the instance status to complete
-		instanceResource, err = mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve instance document", err, log.Data{"instance_id": instanceID})
-			t.FailNow()
+	// Check instance state is completed
+	So(instanceResource.State, ShouldEqual, "completed")
+	So(instanceResource.ImportTasks.SearchTasks[0].DimensionName, ShouldEqual, "aggregate")
+}
+
+func checkStoresConsistent(t *testing.T, p *Pipeline) {
+	graph, err := neo4j.NewDatastore(cfg.Neo4jAddr, p.InstanceID, "")
+	if err != nil {
+		log.ErrorC("Failed to connect to neo4j database", err, nil)
+		t.FailNow()
+	}
+	defer func() {
+		if err = graph.Close(); err != nil {
+			log.ErrorC("Failed to close connection to neo4j database", err, nil)
 		}
+	}()
 
-		// Check instance state is completed
-		So(instanceResource.State, ShouldEqual, "completed")
-		So(instanceResource.ImportTasks.SearchTasks[0].DimensionName, ShouldEqual, "aggregate")
+	verifier := &consistency.Verifier{
+		MongoDB:          cfg.MongoDB,
+		Neo4j:            graph,
+		ElasticsearchURL: cfg.ElasticSearchAPIURL,
+	}
 
-		log.Info("Update instance with meta data and change state to `edition-confirmed`", nil)
-		datasetAPI.PUT("/instances/{instance_id}", instanceID).WithHeaders(headers).
-			WithBytes([]byte(validPUTInstanceMetadataJSON)).Expect().Status(http.StatusOK)
+	report, err := verifier.Verify(context.Background(), p.InstanceID)
+	if err != nil {
+		log.ErrorC("Unable to verify instance across stores", err, log.Data{"instance_id": p.InstanceID})
+		t.FailNow()
+	}
 
-		// Check instance has updated
-		instanceResource, err = mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve instance resource", err, log.Data{"instance_id": instanceID})
-			t.FailNow()
-		}
+	for _, difference := range report.Differences {
+		log.Info("stores disagree", log.Data{"instance_id": p.InstanceID, "difference": difference.String()})
+	}
 
-		So(instanceResource.Alerts, ShouldNotBeNil)
-		So(instanceResource.Edition, ShouldEqual, "2017")
-		So(instanceResource.LatestChanges, ShouldNotBeNil)
-		So(instanceResource.Links.Dimensions.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017/versions/1/dimensions")
-		So(instanceResource.Links.Edition.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017")
-		So(instanceResource.Links.Edition.ID, ShouldEqual, "2017")
-		So(instanceResource.Links.Spatial.HRef, ShouldEqual, "http://ons.gov.uk/geography-list")
-		So(instanceResource.Links.Version.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017/versions/1")
-		So(instanceResource.Links.Version.ID, ShouldEqual, "1")
-		So(instanceResource.ReleaseDate, ShouldEqual, "2017-11-11")
-		So(instanceResource.State, ShouldEqual, "edition-confirmed")
-		So(instanceResource.Temporal, ShouldNotBeNil)
-		So(instanceResource.Version, ShouldEqual, 1)
-
-		// Check Edition has been created
-		editionResource, err := mongo.GetEdition(cfg.MongoDB, "editions", "next.links.self.href", instanceResource.Links.Edition.HRef)
-		if err != nil {
-			log.ErrorC("Unable to retrieve edition resource", err, log.Data{"links.self.href": instanceResource.Links.Edition.HRef})
-			t.FailNow()
-		}
+	p.Artifact("differences", strconv.Itoa(len(report.Differences)))
+	So(report.Differences, ShouldBeEmpty)
+}
 
-		So(editionResource.Next.Edition, ShouldEqual, "2017")
-		So(editionResource.Next.Links.Dataset.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName)
-		So(editionResource.Next.Links.Dataset.ID, ShouldEqual, datasetName)
-		So(editionResource.Next.Links.LatestVersion.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017/versions/1")
-		So(editionResource.Next.Links.LatestVersion.ID, ShouldEqual, "1")
-		So(editionResource.Next.Links.Self.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017")
-		So(editionResource.Next.Links.Versions.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017/versions")
-		So(editionResource.Next.State, ShouldEqual, "edition-confirmed")
-
-		log.Info("Get single observation from pre-published version", nil)
-		observationsResource := datasetAPI.GET("/datasets/{id}/editions/{edition}/versions/{version}/observations", datasetName, "2017", "1").
-			WithQueryString("time=Apr-05&geography=K02000001&aggregate=cpih1dim1G50100").
-			WithHeaders(headers).
-			Expect().Status(http.StatusOK).JSON().Object()
-
-		observationsResource.Value("dimensions").Object().Value("aggregate").Object().Value("option").Object().Value("href").String().Match("/code-lists/cpih1dim1aggid/codes/cpih1dim1G50100$")
-		observationsResource.Value("dimensions").Object().Value("aggregate").Object().Value("option").Object().Value("id").Equal("cpih1dim1G50100")
-		observationsResource.Value("dimensions").Object().Value("geography").Object().Value("option").Object().Value("href").String().Match("/code-lists/uk-only/codes/K02000001$")
-		observationsResource.Value("dimensions").Object().Value("geography").Object().Value("option").Object().Value("id").Equal("K02000001")
-		observationsResource.Value("dimensions").Object().Value("time").Object().Value("option").Object().Value("href").String().Match("/code-lists/mmm-yy/codes/Apr-05$")
-		observationsResource.Value("dimensions").Object().Value("time").Object().Value("option").Object().Value("id").Equal("Apr-05")
-		observationsResource.Value("limit").Equal(10000)
-		observationsResource.Value("links").Object().Value("dataset_metadata").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/2017/versions/1/metadata$")
-		observationsResource.Value("links").Object().Value("self").Object().Value("href").String().Match(".+/datasets/" + datasetName + "/editions/2017/versions/1/observations\\?aggregate=cpih1dim1G50100&geography=K02000001&time=Apr-05$")
-		observationsResource.Value("links").Object().Value("version").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/2017/versions/1$")
-		observationsResource.Value("links").Object().Value("version").Object().Value("id").Equal("1")
-		observationsResource.Value("observations").Array().Length().Equal(1)
-		observationsResource.Value("observations").Array().Element(0).Object().Value("observation").Equal("81.7")
-		observationsResource.Value("offset").Equal(0)
-		observationsResource.Value("total_observations").Equal(1)
-		observationsResource.Value("unit_of_measure").Equal("Pounds Sterling")
-
-		log.Info("Update version with collection_id and change state to associated", nil)
-		datasetAPI.PUT("/datasets/{id}/editions/{edition}/versions/{version}", datasetName, "2017", "1").WithHeaders(headers).
-			WithBytes([]byte(validPUTUpdateVersionToAssociatedJSON)).Expect().Status(http.StatusOK)
-
-		versionResource, err := mongo.GetVersion(cfg.MongoDB, "instances", "id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": instanceID})
-			t.FailNow()
-		}
+func confirmEdition(t *testing.T, p *Pipeline) {
+	edition, version := p.Edition, strconv.Itoa(p.Version)
+	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
+
+	log.Info("Update instance with meta data and change state to `edition-confirmed`", nil)
+	datasetAPI.PUT("/instances/{instance_id}", p.InstanceID).WithHeaders(headers).
+		WithBytes([]byte(createInstanceMetadataJSON(edition))).Expect().Status(http.StatusOK)
+
+	// Check instance has updated
+	instanceResource := p.GetInstance(t)
+
+	So(instanceResource.Alerts, ShouldNotBeNil)
+	So(instanceResource.Edition, ShouldEqual, edition)
+	So(instanceResource.LatestChanges, ShouldNotBeNil)
+	So(instanceResource.Links.Dimensions.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition+"/versions/"+version+"/dimensions")
+	So(instanceResource.Links.Edition.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition)
+	So(instanceResource.Links.Edition.ID, ShouldEqual, edition)
+	So(instanceResource.Links.Spatial.HRef, ShouldEqual, "http://ons.gov.uk/geography-list")
+	So(instanceResource.Links.Version.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition+"/versions/"+version)
+	So(instanceResource.Links.Version.ID, ShouldEqual, version)
+	So(instanceResource.ReleaseDate, ShouldEqual, "2017-11-11")
+	So(instanceResource.State, ShouldEqual, "edition-confirmed")
+	So(instanceResource.Temporal, ShouldNotBeNil)
+	So(instanceResource.Version, ShouldEqual, p.Version)
+
+	// Check Edition has been created
+	editionResource, err := mongo.GetEdition(cfg.MongoDB, "editions", "next.links.self.href", instanceResource.Links.Edition.HRef)
+	if err != nil {
+		log.ErrorC("Unable to retrieve edition resource", err, log.Data{"links.self.href": instanceResource.Links.Edition.HRef})
+		t.FailNow()
+	}
 
-		So(versionResource.CollectionID, ShouldEqual, "308064B3-A808-449B-9041-EA3A2F72CFAC")
-		So(versionResource.State, ShouldEqual, "associated")
+	So(editionResource.Next.Edition, ShouldEqual, edition)
+	So(editionResource.Next.Links.Dataset.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName)
+	So(editionResource.Next.Links.Dataset.ID, ShouldEqual, datasetName)
+	So(editionResource.Next.Links.LatestVersion.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition+"/versions/"+version)
+	So(editionResource.Next.Links.LatestVersion.ID, ShouldEqual, version)
+	So(editionResource.Next.Links.Self.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition)
+	So(editionResource.Next.Links.Versions.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition+"/versions")
+	So(editionResource.Next.State, ShouldEqual, "edition-confirmed")
+
+	log.Info("Get single observation from pre-published version", nil)
+	observationsResource := datasetAPI.GET("/datasets/{id}/editions/{edition}/versions/{version}/observations", datasetName, edition, version).
+		WithQueryString(observationQuery(p.Observation)).
+		WithHeaders(headers).
+		Expect().Status(http.StatusOK).JSON().Object()
 
-		// Check dataset has updated
-		datasetResource, err := mongo.GetDataset(cfg.MongoDB, "datasets", "_id", datasetName)
-		if err != nil {
-			log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
-			t.FailNow()
-		}
+	checkSingleObservation(observationsResource, p, edition, version)
+}
 
+func associateVersion(t *testing.T, p *Pipeline) {
+	edition, version := p.Edition, strconv.Itoa(p.Version)
+	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
+
+	log.Info("Update version with collection_id and change state to associated", nil)
+	datasetAPI.PUT("/datasets/{id}/editions/{edition}/versions/{version}", datasetName, edition, version).WithHeaders(headers).
+		WithBytes([]byte(validPUTUpdateVersionToAssociatedJSON)).Expect().Status(http.StatusOK)
+
+	versionResource, err := mongo.GetVersion(cfg.MongoDB, "instances", "id", p.InstanceID)
+	if err != nil {
+		log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": p.InstanceID})
+		t.FailNow()
+	}
+
+	So(versionResource.CollectionID, ShouldEqual, "308064B3-A808-449B-9041-EA3A2F72CFAC")
+	So(versionResource.State, ShouldEqual, "associated")
+
+	// Check dataset has updated
+	datasetResource, err := mongo.GetDataset(cfg.MongoDB, "datasets", "_id", datasetName)
+	if err != nil {
+		log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
+		t.FailNow()
+	}
+
+	// a dataset with a published version keeps it as current until this version is published
+	if p.NewDataset {
 		So(datasetResource.Current, ShouldBeNil)
-		So(datasetResource.Next.CollectionID, ShouldEqual, "308064B3-A808-449B-9041-EA3A2F72CFAC")
-		So(datasetResource.Next.Links.LatestVersion.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017/versions/1")
-		So(datasetResource.Next.Links.LatestVersion.ID, ShouldEqual, "1")
-		So(datasetResource.Next.State, ShouldEqual, "associated")
-
-		exitHasDownloadsLoop := make(chan bool)
-
-		go func() {
-			time.Sleep(timeout)
-			close(exitHasDownloadsLoop)
-		}()
-
-		// Waiting for version to have downloads before updating state to published
-		hasDownloads := false
-		var csvSize int
-		var csvwSize int
-		var xlsSize int
-	hasDownloadsLoop:
-		for !hasDownloads {
-			select {
-			case <-exitHasDownloadsLoop:
-				break hasDownloadsLoop
-			default:
-
-				instanceResource, err = mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-				if err != nil {
-					log.ErrorC("Unable to retrieve instance document", err, log.Data{"instance_id": instanceID})
-					t.FailNow()
-				}
-
-				if instanceResource.Downloads != nil {
-					if instanceResource.Downloads.XLS != nil {
-						if instanceResource.Downloads.XLS.Private != "" {
-							xlsSize, err = strconv.Atoi(instanceResource.Downloads.XLS.Size)
-							if err != nil {
-								log.ErrorC("cannot convert xls size of type string to integer", err, log.Data{"xls_size": instanceResource.Downloads.XLS.Size})
-								t.FailNow()
-							}
-							So(xlsSize, ShouldBeBetweenOrEqual, 19000, 20000)
-							So(instanceResource.Downloads.XLS.Private, ShouldNotBeEmpty)
-
-							csvSize, err = strconv.Atoi(instanceResource.Downloads.CSV.Size)
-							if err != nil {
-								log.ErrorC("cannot convert csv size of type string to integer", err, log.Data{"csv_size": instanceResource.Downloads.CSV.Size})
-								t.FailNow()
-							}
-							So(csvSize, ShouldBeBetweenOrEqual, 137000, 139000)
-							So(instanceResource.Downloads.CSV.URL, ShouldNotBeEmpty)
-
-							csvwSize, err = strconv.Atoi(instanceResource.Downloads.CSVW.Size)
-							if err != nil {
-								log.ErrorC("cannot convert csvw size of type string to integer", err, log.Data{"csvw_size": instanceResource.Downloads.CSVW.Size})
-								t.FailNow()
-							}
-							So(csvwSize, ShouldBeBetweenOrEqual, 1400, 1600)
-							So(instanceResource.Downloads.CSVW.URL, ShouldNotBeEmpty)
-							hasDownloads = true
-						}
-					}
-				} else {
-					So(instanceResource.State, ShouldEqual, "associated")
-				}
-
-				time.Sleep(time.Millisecond * 200)
-			}
-		}
+	} else {
+		So(datasetResource.Current, ShouldNotBeNil)
+		So(datasetResource.Current.State, ShouldEqual, "published")
+	}
+	So(datasetResource.Next.CollectionID, ShouldEqual, "308064B3-A808-449B-9041-EA3A2F72CFAC")
+	So(datasetResource.Next.Links.LatestVersion.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition+"/versions/"+version)
+	So(datasetResource.Next.Links.LatestVersion.ID, ShouldEqual, version)
+	So(datasetResource.Next.State, ShouldEqual, "associated")
+}
 
-		if hasDownloads == false {
-			err = errors.New("timed out")
-			log.ErrorC("Timed out - failed to get instance document with available downloads", err, log.Data{"instance_id": instanceID, "downloads": instanceResource.Downloads, "timeout": timeout})
-			t.FailNow()
+func checkFullDownloads(t *testing.T, p *Pipeline) {
+	// Waiting for version to have downloads before updating state to published
+	instanceResource := p.WaitForInstance(t, "failed to get instance document with available downloads", func(instanceResource mongo.Instance) bool {
+		if instanceResource.Downloads == nil {
+			So(instanceResource.State, ShouldEqual, "associated")
+			return false
 		}
 
-		log.Info("attempting to read private full file download from S3", nil)
-		instanceResource, err = mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve instance document", err, log.Data{"instance_id": instanceID})
-			t.FailNow()
-		}
+		return instanceResource.Downloads.XLS != nil && instanceResource.Downloads.XLS.Private != ""
+	})
 
-		logData := log.Data{
-			"private_csv_link":  instanceResource.Downloads.CSV.Private,
-			"private_csv_size":  instanceResource.Downloads.CSV.Size,
-			"private_csvw_link": instanceResource.Downloads.CSVW.Private,
-			"private_csvw_size": instanceResource.Downloads.CSVW.Size,
-			"private_xls_link":  instanceResource.Downloads.XLS.Private,
-			"private_xls_size":  instanceResource.Downloads.XLS.Size,
-		}
-		log.Debug("Pre publish full downloads have been generated", logData)
+	var err error
+	p.Downloads.XLSSize, err = strconv.Atoi(instanceResource.Downloads.XLS.Size)
+	if err != nil {
+		log.ErrorC("cannot convert xls size of type string to integer", err, log.Data{"xls_size": instanceResource.Downloads.XLS.Size})
+		t.FailNow()
+	}
+	So(p.Downloads.XLSSize, ShouldBeGreaterThan, 0)
+	So(instanceResource.Downloads.XLS.Private, ShouldNotBeEmpty)
 
-		privateCSVURL, err := url.Parse(instanceResource.Downloads.CSV.Private)
-		if err != nil {
-			log.ErrorC("failed to parse private CSV URL", err, log.Data{"url": instanceResource.Downloads.CSV.Private})
-			t.FailNow()
-		}
+	p.Downloads.CSVSize, err = strconv.Atoi(instanceResource.Downloads.CSV.Size)
+	if err != nil {
+		log.ErrorC("cannot convert csv size of type string to integer", err, log.Data{"csv_size": instanceResource.Downloads.CSV.Size})
+		t.FailNow()
+	}
+	So(p.Downloads.CSVSize, ShouldBeGreaterThan, 0)
+	So(instanceResource.Downloads.CSV.URL, ShouldNotBeEmpty)
 
-		privateCSVFilename := privateCSVURL.Path
+	p.Downloads.CSVWSize, err = strconv.Atoi(instanceResource.Downloads.CSVW.Size)
+	if err != nil {
+		log.ErrorC("cannot convert csvw size of type string to integer", err, log.Data{"csvw_size": instanceResource.Downloads.CSVW.Size})
+		t.FailNow()
+	}
+	So(p.Downloads.CSVWSize, ShouldBeGreaterThan, 0)
+	So(instanceResource.Downloads.CSVW.URL, ShouldNotBeEmpty)
+
+	p.Artifact("private_csv", instanceResource.Downloads.CSV.Private)
+	p.Artifact("private_csvw", instanceResource.Downloads.CSVW.Private)
+	p.Artifact("private_xls", instanceResource.Downloads.XLS.Private)
+
+	logData := log.Data{
+		"private_csv_link":  instanceResource.Downloads.CSV.Private,
+		"private_csv_size":  instanceResource.Downloads.CSV.Size,
+		"private_csvw_link": instanceResource.Downloads.CSVW.Private,
+		"private_csvw_size": instanceResource.Downloads.CSVW.Size,
+		"private_xls_link":  instanceResource.Downloads.XLS.Private,
+		"private_xls_size":  instanceResource.Downloads.XLS.Size,
+	}
+	log.Debug("Pre publish full downloads have been generated", logData)
 
-		// read csv download from s3
-		privateCSVFile, err := getS3File(region, bucket, privateCSVFilename, true)
-		if err != nil {
-			log.ErrorC("unable to find csv download in s3", err, nil)
-			t.Error()
-			t.FailNow()
-		}
-		privateCSVReader := csv.NewReader(privateCSVFile)
-		if err = checkFileRowCount(privateCSVReader, 1511); err != nil {
-			log.ErrorC("unable to check file row count", err, nil)
-			t.FailNow()
-		}
+	log.Info("attempting to read private full file download from S3", nil)
+	privateCSVURL, err := url.Parse(instanceResource.Downloads.CSV.Private)
+	if err != nil {
+		log.ErrorC("failed to parse private CSV URL", err, log.Data{"url": instanceResource.Downloads.CSV.Private})
+		t.FailNow()
+	}
 
-		log.Debug("Pre publish - attempting to download full CSV file from the download service", logData)
-		testFileDownload(instanceResource.Downloads.CSV.URL, csvSize, false)
+	privateCSVFilename := privateCSVURL.Path
 
-		log.Debug("Pre publish - attempting to download full CSVW file from the download service", logData)
-		testFileDownload(instanceResource.Downloads.CSVW.URL, csvwSize, false)
+	// read csv download from s3
+	privateCSVFile, err := getS3File(region, bucket, privateCSVFilename, true)
+	if err != nil {
+		log.ErrorC("unable to find csv download in s3", err, nil)
+		t.Error()
+		t.FailNow()
+	}
+	privateCSVReader := csv.NewReader(privateCSVFile)
+	if err = checkFileRowCount(privateCSVReader, int64(p.V4File.Observations+1)); err != nil {
+		log.ErrorC("unable to check file row count", err, nil)
+		t.FailNow()
+	}
 
-		log.Debug("Pre publish - attempting to download full XLS file from the download service", logData)
-		testFileDownload(instanceResource.Downloads.XLS.URL, xlsSize, false)
+	log.Debug("Pre publish - attempting to download full CSV file from the download service", logData)
+	testFileDownload(instanceResource.Downloads.CSV.URL, p.Downloads.CSVSize, false)
 
-		log.Info("Then an authenticated user should be able to filter a dataset", nil)
+	log.Debug("Pre publish - attempting to download full CSVW file from the download service", logData)
+	testFileDownload(instanceResource.Downloads.CSVW.URL, p.Downloads.CSVWSize, false)
 
-		prePublishFilterBlueprintID, prePublishFilterOutputID := testFiltering(t, filterAPI, instanceID, false)
+	log.Debug("Pre publish - attempting to download full XLS file from the download service", logData)
+	testFileDownload(instanceResource.Downloads.XLS.URL, p.Downloads.XLSSize, false)
+}
 
-		log.Info("pre publish filter test passed", log.Data{
-			"filter_blueprint": prePublishFilterBlueprintID,
-			"filter_output":    prePublishFilterOutputID,
+func checkPrePublishFiltering(t *testing.T, p *Pipeline) {
+	filterAPI := httpexpect.New(t, cfg.FilterAPIURL)
+
+	log.Info("Then an authenticated user should be able to filter a dataset", nil)
+
+	prePublishFilterBlueprintID, prePublishFilterOutputID := testFiltering(t, filterAPI, p.InstanceID, p.Edition, strconv.Itoa(p.Version), false)
+
+	log.Info("pre publish filter test passed", log.Data{
+		"filter_blueprint": prePublishFilterBlueprintID,
+		"filter_output":    prePublishFilterOutputID,
+	})
+
+	p.Artifact("filter_blueprint_id", prePublishFilterBlueprintID)
+	p.Artifact("filter_output_id", prePublishFilterOutputID)
+
+	teardownFilter(t, prePublishFilterBlueprintID, prePublishFilterOutputID)
+}
+
+func publishVersion(t *testing.T, p *Pipeline) {
+	edition, version := p.Edition, strconv.Itoa(p.Version)
+	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
+
+	log.Info("STEP 6 - Update version to a state of published", nil)
+	datasetAPI.PUT("/datasets/{id}/editions/{edition}/versions/{version}", datasetName, edition, version).WithHeaders(headers).
+		WithBytes([]byte(`{"state":"published"}`)).Expect().Status(http.StatusOK)
+
+	versionResource, err := mongo.GetVersion(cfg.MongoDB, "instances", "id", p.InstanceID)
+	if err != nil {
+		log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": p.InstanceID})
+		t.FailNow()
+	}
+
+	instanceResource := p.GetInstance(t)
+
+	log.Info("Check edition has updated", nil)
+	editionResource, err := mongo.GetEdition(cfg.MongoDB, "editions", "current.links.self.href", instanceResource.Links.Edition.HRef)
+	if err != nil {
+		log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
+		t.FailNow()
+	}
+
+	So(editionResource.Next.State, ShouldEqual, "published")
+	So(editionResource.Current, ShouldNotBeNil)
+	So(editionResource.Current.State, ShouldEqual, "published")
+
+	log.Info("Check dataset has updated", nil)
+	datasetResource, err := mongo.GetDataset(cfg.MongoDB, "datasets", "_id", datasetName)
+	if err != nil {
+		log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
+		t.FailNow()
+	}
+
+	So(datasetResource.Current, ShouldNotBeNil)
+	So(datasetResource.Current.Links.LatestVersion.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/"+edition+"/versions/"+version)
+	So(datasetResource.Current.State, ShouldEqual, "published")
+
+	log.Info("Get single observation post-published version", nil)
+	postObservationsResource := datasetAPI.GET("/datasets/{id}/editions/{edition}/versions/{version}/observations", datasetName, edition, version).
+		WithQueryString(observationQuery(p.Observation)).WithHeader(authorizationTokenHeader, authorizationToken).
+		Expect().Status(http.StatusOK).JSON().Object()
+
+	checkSingleObservation(postObservationsResource, p, edition, version)
+
+	log.Info("Get downloads link from version document", nil)
+	csvURL := versionResource.Downloads.CSV.URL
+
+	log.Debug("getting downloads from the version",
+		log.Data{
+			"csv_link":  versionResource.Downloads.CSV.URL,
+			"csvw_link": versionResource.Downloads.CSVW.URL,
+			"xls_link":  versionResource.Downloads.XLS.URL,
 		})
 
-		prePublishFilterBlueprint := &mongo.Doc{
-			Database:   cfg.MongoFiltersDB,
-			Collection: "filters",
-			Key:        "filter_id",
-			Value:      prePublishFilterBlueprintID,
-		}
+	var req *http.Request
+	req, err = http.NewRequest("GET", csvURL, nil)
+	req.Header.Set(authorizationTokenHeader, authorizationToken)
 
-		prePublishFilterOutput := &mongo.Doc{
-			Database:   cfg.MongoFiltersDB,
-			Collection: "filterOutputs",
-			Key:        "filter_id",
-			Value:      prePublishFilterOutputID,
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Error(err, nil)
+	}
+	log.Info("get csv response", log.Data{
+		"response_status": response.StatusCode,
+	})
+	defer func() {
+		if err = response.Body.Close(); err != nil {
+			log.ErrorC("get downloads link body", err, log.Data{"csv_link": csvURL})
 		}
+	}()
 
-		//remove filter output
-		if err = mongo.Teardown(prePublishFilterBlueprint, prePublishFilterOutput); err != nil {
-			if err != mgo.ErrNotFound {
-				log.ErrorC("failed to remove filter output resource", err, log.Data{"filter_output_id": prePublishFilterOutputID})
-				hasRemovedAllResources = false
-			}
-		}
+	csvReader := csv.NewReader(response.Body)
 
-		log.Info("STEP 6 - Update version to a state of published", nil)
-		datasetAPI.PUT("/datasets/{id}/editions/{edition}/versions/{version}", datasetName, "2017", "1").WithHeaders(headers).
-			WithBytes([]byte(`{"state":"published"}`)).Expect().Status(http.StatusOK)
+	headerRow, err := csvReader.Read()
+	if err != nil {
+		log.ErrorC("unable to read header row", err, log.Data{"csv_url": csvURL})
+	}
 
-		versionResource, err = mongo.GetVersion(cfg.MongoDB, "instances", "id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": instanceID})
-			t.FailNow()
-		}
+	So(len(headerRow), ShouldEqual, len(p.V4File.Header.Row))
 
-		log.Info("Check edition has updated", nil)
-		editionResource, err = mongo.GetEdition(cfg.MongoDB, "editions", "current.links.self.href", instanceResource.Links.Edition.HRef)
+	log.Info("check the number of rows and anything else (e.g. meta data)", nil)
+	numberOfCSVRows := 0
+	for {
+		_, err = csvReader.Read()
 		if err != nil {
-			log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
+			if err == io.EOF {
+				break
+			}
+			log.ErrorC("unable to read row", err, log.Data{"csv_url": csvURL})
 			t.FailNow()
 		}
+		numberOfCSVRows++
+	}
+	So(numberOfCSVRows, ShouldEqual, p.V4File.Observations)
 
-		So(editionResource.Next.State, ShouldEqual, "published")
-		So(editionResource.Current, ShouldNotBeNil)
-		So(editionResource.Current.State, ShouldEqual, "published")
+	testFileDownload(versionResource.Downloads.CSV.URL, p.Downloads.CSVSize, true)
+	testFileDownload(versionResource.Downloads.CSVW.URL, p.Downloads.CSVWSize, true)
+	testFileDownload(versionResource.Downloads.XLS.URL, p.Downloads.XLSSize, true)
 
-		log.Info("Check dataset has updated", nil)
-		datasetResource, err = mongo.GetDataset(cfg.MongoDB, "datasets", "_id", datasetName)
-		if err != nil {
-			log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
-			t.FailNow()
-		}
+	// Waiting for version to have public downloads
+	var versionResourcePostPublish mongo.Version
+	p.WaitForDocument(t, "waiting for public full download links to be generated", cfg.MongoDB, "instances", "id", p.InstanceID, &versionResourcePostPublish, func() bool {
+		return versionResourcePostPublish.Downloads != nil && versionResourcePostPublish.Downloads.XLS != nil && versionResourcePostPublish.Downloads.XLS.Public != ""
+	})
 
-		So(datasetResource.Current, ShouldNotBeNil)
-		So(datasetResource.Current.Links.LatestVersion.HRef, ShouldEqual, cfg.DatasetAPIURL+"/datasets/"+datasetName+"/editions/2017/versions/1")
-		So(datasetResource.Current.State, ShouldEqual, "published")
+	p.Artifact("public_csv", versionResourcePostPublish.Downloads.CSV.Public)
+	p.Artifact("public_csvw", versionResourcePostPublish.Downloads.CSVW.Public)
+	p.Artifact("public_xls", versionResourcePostPublish.Downloads.XLS.Public)
+}
 
-		log.Info("Check data exists in elaticsearch by calling search API to find dimension option", nil)
-		getSearchResponse := searchAPI.GET("/search/datasets/{id}/editions/{edition}/versions/{version}/dimensions/{dimension}", datasetName, versionResource.Edition, strconv.Itoa(versionResource.Version), "aggregate").
-			WithQuery("q", "Overall Index").WithHeader(authorizationTokenHeader, authorizationToken).Expect().Status(http.StatusOK).JSON().Object()
-
-		getSearchResponse.Value("count").Equal(1)
-		getSearchResponse.Value("items").Array().Length().Equal(1)
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("code").Equal("cpih1dim1A0")
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("dimension_option_url").Equal("http://localhost:22400/code-lists/cpih1dim1aggid/codes/cpih1dim1A0")
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("has_data").Equal(true)
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("label").Equal("Overall Index")
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().NotContainsKey("code")
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Length().Equal(2)
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(0).Object().Value("start").Equal(1)
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(0).Object().Value("end").Equal(7)
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(1).Object().Value("start").Equal(9)
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(1).Object().Value("end").Equal(13)
-		getSearchResponse.Value("items").Array().Element(0).Object().Value("number_of_children").Equal(12)
-		getSearchResponse.Value("limit").Equal(20)
-		getSearchResponse.Value("offset").Equal(0)
-
-		log.Info("Get single observation post-published version", nil)
-		postObservationsResource := datasetAPI.GET("/datasets/{id}/editions/{edition}/versions/{version}/observations", datasetName, "2017", "1").
-			WithQueryString("time=Apr-05&geography=K02000001&aggregate=cpih1dim1G50100").WithHeader(authorizationTokenHeader, authorizationToken).
-			Expect().Status(http.StatusOK).JSON().Object()
-
-		postObservationsResource.Value("dimensions").Object().Value("aggregate").Object().Value("option").Object().Value("href").String().Match("/code-lists/cpih1dim1aggid/codes/cpih1dim1G50100$")
-		postObservationsResource.Value("dimensions").Object().Value("aggregate").Object().Value("option").Object().Value("id").Equal("cpih1dim1G50100")
-		postObservationsResource.Value("dimensions").Object().Value("geography").Object().Value("option").Object().Value("href").String().Match("/code-lists/uk-only/codes/K02000001$")
-		postObservationsResource.Value("dimensions").Object().Value("geography").Object().Value("option").Object().Value("id").Equal("K02000001")
-		postObservationsResource.Value("dimensions").Object().Value("time").Object().Value("option").Object().Value("href").String().Match("/code-lists/mmm-yy/codes/Apr-05$")
-		postObservationsResource.Value("dimensions").Object().Value("time").Object().Value("option").Object().Value("id").Equal("Apr-05")
-		postObservationsResource.Value("limit").Equal(10000)
-		postObservationsResource.Value("links").Object().Value("dataset_metadata").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/2017/versions/1/metadata$")
-		postObservationsResource.Value("links").Object().Value("self").Object().Value("href").String().Match(".+/datasets/" + datasetName + "/editions/2017/versions/1/observations\\?aggregate=cpih1dim1G50100&geography=K02000001&time=Apr-05$")
-		postObservationsResource.Value("links").Object().Value("version").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/2017/versions/1$")
-		postObservationsResource.Value("links").Object().Value("version").Object().Value("id").Equal("1")
-		postObservationsResource.Value("observations").Array().Length().Equal(1)
-		postObservationsResource.Value("observations").Array().Element(0).Object().Value("observation").Equal("81.7")
-		postObservationsResource.Value("offset").Equal(0)
-		postObservationsResource.Value("total_observations").Equal(1)
-		postObservationsResource.Value("unit_of_measure").Equal("Pounds Sterling")
-
-		versionResourcePostPublish, err := mongo.GetVersion(cfg.MongoDB, "instances", "id", instanceID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": instanceID})
-			t.FailNow()
-		}
-		logData["after_loop_public_csv_link"] = versionResourcePostPublish.Downloads.CSV.Public
-		logData["after_loop_public_csvw_link"] = versionResourcePostPublish.Downloads.CSVW.Public
-		logData["after_loop_public_xls_link"] = versionResourcePostPublish.Downloads.XLS.Public
-		log.Debug("Pre publish full downloads have been generated", logData)
-
-		log.Info("Get downloads link from version document", nil)
-		csvURL := versionResource.Downloads.CSV.URL
-
-		log.Debug("getting downloads from the version",
-			log.Data{
-				"csv_link":  versionResource.Downloads.CSV.URL,
-				"csvw_link": versionResource.Downloads.CSVW.URL,
-				"xls_link":  versionResource.Downloads.XLS.URL,
-			})
-
-		var req *http.Request
-		req, err = http.NewRequest("GET", csvURL, nil)
-		req.Header.Set(authorizationTokenHeader, authorizationToken)
-
-		response, err := http.DefaultClient.Do(req)
-		if err != nil {
-			log.Error(err, nil)
-		}
-		log.Info("get csv response", log.Data{
-			"response_status": response.StatusCode,
-		})
-		defer func() {
-			if err = response.Body.Close(); err != nil {
-				log.ErrorC("get downloads link body", err, logData)
-			}
-		}()
+// checkPublishedSearch checks a dimension option of the published version can
+// be found by its label through the search API
+func checkPublishedSearch(t *testing.T, p *Pipeline) {
+	searchAPI := httpexpect.New(t, cfg.SearchAPIURL)
 
-		csvReader := csv.NewReader(response.Body)
+	log.Info("Check data exists in elaticsearch by calling search API to find dimension option", nil)
+	getSearchResponse := searchAPI.GET("/search/datasets/{id}/editions/{edition}/versions/{version}/dimensions/{dimension}", datasetName, p.Edition, strconv.Itoa(p.Version), "aggregate").
+		WithQuery("q", "Overall Index").WithHeader(authorizationTokenHeader, authorizationToken).Expect().Status(http.StatusOK).JSON().Object()
+
+	getSearchResponse.Value("count").Equal(1)
+	getSearchResponse.Value("items").Array().Length().Equal(1)
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("code").Equal("cpih1dim1A0")
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("dimension_option_url").Equal("http://localhost:22400/code-lists/cpih1dim1aggid/codes/cpih1dim1A0")
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("has_data").Equal(true)
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("label").Equal("Overall Index")
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().NotContainsKey("code")
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Length().Equal(2)
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(0).Object().Value("start").Equal(1)
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(0).Object().Value("end").Equal(7)
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(1).Object().Value("start").Equal(9)
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("matches").Object().Value("label").Array().Element(1).Object().Value("end").Equal(13)
+	getSearchResponse.Value("items").Array().Element(0).Object().Value("number_of_children").Equal(12)
+	getSearchResponse.Value("limit").Equal(20)
+	getSearchResponse.Value("offset").Equal(0)
+}
 
-		headerRow, err := csvReader.Read()
-		if err != nil {
-			log.ErrorC("unable to read header row", err, log.Data{"csv_url": csvURL})
-		}
+func checkDownloadContent(t *testing.T, p *Pipeline) {
+	versionResource, err := mongo.GetVersion(cfg.MongoDB, "instances", "id", p.InstanceID)
+	if err != nil {
+		log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": p.InstanceID})
+		t.FailNow()
+	}
 
-		So(len(headerRow), ShouldEqual, 7)
-
-		log.Info("check the number of rows and anything else (e.g. meta data)", nil)
-		numberOfCSVRows := 0
-		for {
-			_, err = csvReader.Read()
-			if err != nil {
-				if err == io.EOF {
-					break
-				}
-				log.ErrorC("unable to read row", err, log.Data{"csv_url": csvURL})
-				t.FailNow()
-			}
-			numberOfCSVRows++
-		}
-		So(numberOfCSVRows, ShouldEqual, 1510)
-
-		testFileDownload(versionResource.Downloads.CSV.URL, csvSize, true)
-		testFileDownload(versionResource.Downloads.CSVW.URL, csvwSize, true)
-		testFileDownload(versionResource.Downloads.XLS.URL, xlsSize, true)
-
-		exitHasPublicDownloadsLoop := make(chan bool)
-
-		go func() {
-			time.Sleep(timeout)
-			close(exitHasPublicDownloadsLoop)
-		}()
-
-		// Waiting for version to have downloads before updating state to published
-	hasPublicDownloadsLoop:
-		for {
-			select {
-			case <-exitHasPublicDownloadsLoop:
-				err = errors.New("timed out")
-				log.ErrorC("timeout waiting for public full download links to be generated", err, nil)
-				t.FailNow()
-			default:
-				var versionResourcePostPublish mongo.Version
-				versionResourcePostPublish, err = mongo.GetVersion(cfg.MongoDB, "instances", "id", instanceID)
-				if err != nil {
-					log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": instanceID})
-					t.FailNow()
-				}
-
-				if versionResourcePostPublish.Downloads.XLS.Public != "" {
-					break hasPublicDownloadsLoop
-				}
-			}
-		}
+	datasetResource, err := mongo.GetDataset(cfg.MongoDB, "datasets", "_id", datasetName)
+	if err != nil {
+		log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
+		t.FailNow()
+	}
 
-		log.Info("Then an api customer should be able to filter a dataset and be able to download", nil)
+	csvURL := versionResource.Downloads.CSV.URL
+	csvFile := getDownload(t, csvURL)
 
-		filterBlueprintID, filterOutputID := testFiltering(t, filterAPI, instanceID, true)
+	log.Info("Check every row of the csv download is a row of the v4 file", nil)
+	result, err := downloads.VerifyCSV(openV4File(t, p.Filename), bytes.NewReader(csvFile))
+	if err != nil {
+		log.ErrorC("Unable to read csv download", err, log.Data{"csv_url": csvURL})
+		t.FailNow()
+	}
+	checkDownloadResult(p, result, p.V4File.Observations)
 
-		filterBlueprint := &mongo.Doc{
-			Database:   cfg.MongoFiltersDB,
-			Collection: "filters",
-			Key:        "filter_id",
-			Value:      filterBlueprintID,
-		}
+	header, err := downloads.Header(bytes.NewReader(csvFile))
+	if err != nil {
+		log.ErrorC("Unable to read csv download header", err, log.Data{"csv_url": csvURL})
+		t.FailNow()
+	}
 
-		filterOutput := &mongo.Doc{
-			Database:   cfg.MongoFiltersDB,
-			Collection: "filterOutputs",
-			Key:        "filter_id",
-			Value:      filterOutputID,
-		}
+	log.Info("Check the csvw download describes the csv download", nil)
+	csvwURL := versionResource.Downloads.CSVW.URL
+	result, err = downloads.VerifyCSVW(bytes.NewReader(getDownload(t, csvwURL)), downloads.CSVWExpectations{
+		CSVURL: csvURL,
+		Header: header,
+		Title:  datasetResource.Current.Title,
+	})
+	if err != nil {
+		log.ErrorC("Unable to read csvw download", err, log.Data{"csvw_url": csvwURL})
+		t.FailNow()
+	}
+	checkDownloadResult(p, result, len(header))
 
-		// remove filter output
-		if err = mongo.Teardown(filterBlueprint, filterOutput); err != nil {
-			if err != mgo.ErrNotFound {
-				log.ErrorC("failed to remove filter output resource", err, log.Data{"filter_output_id": filterOutputID})
-				hasRemovedAllResources = false
-			}
-		}
+	log.Info("Check the xlsx download holds the rows of the v4 file and the dataset metadata", nil)
+	xlsURL := versionResource.Downloads.XLS.URL
+	xlsFile := getDownload(t, xlsURL)
+	workbook, err := downloads.ReadXLSX(bytes.NewReader(xlsFile), int64(len(xlsFile)))
+	if err != nil {
+		log.ErrorC("Unable to read xlsx download", err, log.Data{"xls_url": xlsURL})
+		t.FailNow()
+	}
 
-		var docs []*mongo.Doc
+	result, err = downloads.VerifyXLSX(openV4File(t, p.Filename), workbook, downloads.XLSXExpectations{
+		Metadata: []string{datasetResource.Current.Title, datasetResource.Current.Description},
+	})
+	if err != nil {
+		log.ErrorC("Unable to read v4 file", err, log.Data{"v4_file": p.Filename})
+		t.FailNow()
+	}
+	checkDownloadResult(p, result, p.V4File.Observations)
+}
 
-		dataset := &mongo.Doc{
-			Database:   cfg.MongoDB,
-			Collection: "datasets",
-			Key:        "_id",
-			Value:      datasetName,
-		}
+// checkDownloadResult logs each mismatch found in a download and checks there
+// were none, and that the expected number of rows were checked
+func checkDownloadResult(p *Pipeline, result *downloads.Result, rows int) {
+	for _, mismatch := range result.Mismatches {
+		log.Info("download does not match", log.Data{"download": result.Download, "mismatch": mismatch.String()})
+	}
+
+	p.Artifact(result.Download+"_mismatches", strconv.Itoa(len(result.Mismatches)+result.Truncated))
+	So(result.Mismatches, ShouldBeEmpty)
+	So(result.Rows, ShouldEqual, rows)
+}
 
-		importJob := &mongo.Doc{
-			Database:   cfg.MongoImportsDB,
-			Collection: "imports",
-			Key:        "id",
-			Value:      jobID,
+// getDownload returns the whole of a published download
+func getDownload(t *testing.T, url string) []byte {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		log.ErrorC("Unable to create download request", err, log.Data{"url": url})
+		t.FailNow()
+	}
+	req.Header.Set(authorizationTokenHeader, authorizationToken)
+
+	response, err := rchttp.DefaultClient.Do(context.Background(), req)
+	if err != nil {
+		log.ErrorC("Unable to get download", err, log.Data{"url": url})
+		t.FailNow()
+	}
+	defer func() {
+		if err = response.Body.Close(); err != nil {
+			log.ErrorC("get download body", err, log.Data{"url": url})
 		}
+	}()
 
-		instance := &mongo.Doc{
-			Database:   cfg.MongoDB,
-			Collection: "instances",
-			Key:        "id",
-			Value:      instanceID,
+	So(response.StatusCode, ShouldEqual, http.StatusOK)
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		log.ErrorC("Unable to read download", err, log.Data{"url": url})
+		t.FailNow()
+	}
+
+	return body
+}
+
+// openV4File opens the local v4 file, closing it when the stage ends
+func openV4File(t *testing.T, filename string) io.Reader {
+	file, err := os.Open(filename)
+	if err != nil {
+		log.ErrorC("Unable to open v4 file", err, log.Data{"v4_file": filename})
+		t.FailNow()
+	}
+
+	Reset(func() {
+		if err := file.Close(); err != nil {
+			log.ErrorC("Unable to close v4 file", err, log.Data{"v4_file": filename})
 		}
+	})
+
+	return file
+}
+
+func checkPublishedFiltering(t *testing.T, p *Pipeline) {
+	filterAPI := httpexpect.New(t, cfg.FilterAPIURL)
+
+	log.Info("Then an api customer should be able to filter a dataset and be able to download", nil)
+
+	filterBlueprintID, filterOutputID := testFiltering(t, filterAPI, p.InstanceID, p.Edition, strconv.Itoa(p.Version), true)
+
+	p.Artifact("filter_blueprint_id", filterBlueprintID)
+	p.Artifact("filter_output_id", filterOutputID)
+
+	teardownFilter(t, filterBlueprintID, filterOutputID)
+}
+
+// filterContentBlueprints are filters of the v4 test file whose output is
+// compared row by row with the rows of the v4 file they select
+var filterContentBlueprints = []struct {
+	name       string
+	dimensions []downloads.FilterDimension
+	rows       int
+}{
+	{
+		name: "multiple-options",
+		dimensions: []downloads.FilterDimension{
+			{Name: "time", Options: []string{"Apr-05", "Apr-15"}},
+			{Name: "aggregate", Options: []string{"cpih1dim1A0", "cpih1dim1G100000", "cpih1dim1S40302"}},
+		},
+		rows: 5,
+	},
+	{
+		name: "unfiltered-dimensions",
+		dimensions: []downloads.FilterDimension{
+			{Name: "time", Options: []string{"Dec-14"}},
+		},
+		rows: 8,
+	},
+	{
+		name: "no-rows",
+		dimensions: []downloads.FilterDimension{
+			{Name: "time", Options: []string{"Dec-14"}},
+			{Name: "aggregate", Options: []string{"cpih1dim1G100000"}},
+		},
+	},
+}
+
+func checkFilterContent(t *testing.T, p *Pipeline) {
+	filterAPI := httpexpect.New(t, cfg.FilterAPIURL)
+
+	for _, blueprint := range filterContentBlueprints {
+		log.Info("Then the output of a filter holds exactly the rows of the v4 file it selects", log.Data{"filter": blueprint.name})
+
+		expected := filterV4File(t, p.Filename, blueprint.dimensions)
+		So(len(expected.Rows), ShouldEqual, blueprint.rows)
+
+		checkFilterContentMatches(t, filterAPI, p, blueprint.name, blueprint.dimensions, expected)
+	}
+}
+
+// checkFilterContentMatches submits a filter of the published version and
+// compares its output with the rows it is expected to output
+func checkFilterContentMatches(t *testing.T, filterAPI *httpexpect.Expect, p *Pipeline, name string, dimensions []downloads.FilterDimension, expected *downloads.Expected) {
+	filterBlueprintResponse := filterAPI.POST("/filters").
+		WithQuery("submitted", "true").
+		WithHeader(authorizationTokenHeader, authorizationToken).
+		WithBytes([]byte(GetPOSTCreateFilterJSON(datasetName, p.Edition, strconv.Itoa(p.Version), dimensions))).
+		Expect().Status(http.StatusCreated).
+		JSON().Object()
+
+	filterBlueprintID := filterBlueprintResponse.Value("filter_id").String().Raw()
+	filterOutputID := filterBlueprintResponse.Value("links").Object().Value("filter_output").Object().Value("id").String().Raw()
+	p.Artifact(name+"_filter_output_id", filterOutputID)
 
+	var filterOutput mongo.Filter
+	p.WaitForDocument(t, "waiting for filter output to complete", cfg.MongoFiltersDB, "filterOutputs", "filter_id", filterOutputID, &filterOutput, func() bool {
+		return filterOutput.State == "completed"
+	})
+	So(filterOutput.Downloads, ShouldNotBeNil)
+	So(filterOutput.Downloads.CSV, ShouldNotBeNil)
+	So(filterOutput.Downloads.XLS, ShouldNotBeNil)
+
+	results := checkFilterOutput(t, expected, getDownload(t, filterOutput.Downloads.CSV.HRef), getDownload(t, filterOutput.Downloads.XLS.HRef))
+	for _, result := range results {
+		p.Artifact(name+"_"+result.Download+"_mismatches", strconv.Itoa(len(result.Mismatches)+result.Truncated))
+	}
+
+	teardownFilter(t, filterBlueprintID, filterOutputID)
+}
+
+func teardownEndToEnd(t *testing.T, p *Pipeline) {
+	hasRemovedAllResources := true
+
+	instanceResource := p.GetInstance(t)
+
+	var docs []*mongo.Doc
+
+	dataset := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: "datasets",
+		Key:        "_id",
+		Value:      datasetName,
+	}
+
+	importJob := &mongo.Doc{
+		Database:   cfg.MongoImportsDB,
+		Collection: "imports",
+		Key:        "id",
+		Value:      p.JobID,
+	}
+
+	instance := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: "instances",
+		Key:        "id",
+		Value:      p.InstanceID,
+	}
+
+	docs = append(docs, dataset, importJob, instance)
+
+	if instanceResource.Links.Edition != nil {
 		edition := &mongo.Doc{
 			Database:   cfg.MongoDB,
 			Collection: "editions",
 			Key:        "current.links.self.href",
 			Value:      instanceResource.Links.Edition.HRef,
 		}
+		docs = append(docs, edition)
+	}
 
-		docs = append(docs, dataset, importJob, instance, edition)
-
-		log.Debug("tearing down", nil)
+	log.Debug("tearing down", nil)
 
-		// remove all mongo documents created in the test
-		if err = mongo.Teardown(docs...); err != nil {
-			if err != mgo.ErrNotFound {
-				log.ErrorC("failed to remove edition resource", err, log.Data{"links.self.href": instanceResource.Links.Edition.HRef})
-				hasRemovedAllResources = false
-			}
-		}
-
-		// remove all dimension options from mongo collection
-		if err = mongo.TeardownAll(cfg.MongoDB, "dimension.options"); err != nil {
-			if err != mgo.ErrNotFound {
-				log.ErrorC("failed to remove edition resource", err, log.Data{"links.self.href": instanceResource.Links.Edition.HRef})
-				hasRemovedAllResources = false
-			}
+	// remove all mongo documents created in the test
+	if err := mongo.Teardown(docs...); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("failed to remove mongo resources", err, log.Data{"instance_id": p.InstanceID})
+			hasRemovedAllResources = false
 		}
+	}
 
-		// remove elasticsearch index for instance and dimension (call elasticsearch directly)
-		if _, err = elasticsearch.DeleteIndex(cfg.ElasticSearchAPIURL + "/" + instanceID + "_aggregate"); err != nil {
-			log.ErrorC("Failed to delete index from elasticsearch", err, nil)
+	// remove all dimension options from mongo collection
+	if err := mongo.TeardownAll(cfg.MongoDB, "dimension.options"); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("failed to remove dimension options", err, log.Data{"instance_id": p.InstanceID})
 			hasRemovedAllResources = false
 		}
+	}
 
-		// remove instance from neo4j
-		datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, "")
-		if err != nil {
-			log.ErrorC("Failed to connecton to neo4j database", err, nil)
-			t.FailNow()
-		}
+	// remove elasticsearch index for instance and dimension (call elasticsearch directly)
+	// the index will not exist if the import failed before it was built
+	if status, err := elasticsearch.DeleteIndex(cfg.ElasticSearchAPIURL + "/" + p.InstanceID + "_aggregate"); err != nil && status != http.StatusNotFound {
+		log.ErrorC("Failed to delete index from elasticsearch", err, nil)
+		hasRemovedAllResources = false
+	}
 
-		if err = datastore.TeardownInstance(); err != nil {
-			log.ErrorC("Failed to delete all instances in neo4j database", err, nil)
-			hasRemovedAllResources = false
-		}
+	// remove instance from neo4j
+	datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, p.InstanceID, "")
+	if err != nil {
+		log.ErrorC("Failed to connecton to neo4j database", err, nil)
+		t.FailNow()
+	}
 
-		// remove test file from s3
-		if err := deleteS3File("eu-west-1", "ons-dp-cmd-test", "v4TestFile.csv"); err != nil {
-			log.ErrorC("Failed to remove test file from s3", err, nil)
-			hasRemovedAllResources = false
-		} else {
-			log.Info("successfully removed file from aws", nil)
-		}
+	if err = datastore.TeardownInstance(); err != nil {
+		log.ErrorC("Failed to delete all instances in neo4j database", err, nil)
+		hasRemovedAllResources = false
+	}
+
+	// remove test file from s3
+	if err := deleteS3File(region, bucketName, p.Filename); err != nil {
+		log.ErrorC("Failed to remove test file from s3", err, nil)
+		hasRemovedAllResources = false
+	} else {
+		log.Info("successfully removed file from aws", nil)
+	}
+
+	if !hasRemovedAllResources {
+		t.FailNow()
+	}
+}
+
+// observationQuery returns the query string selecting the observation
+func observationQuery(o *Observation) string {
+	query := url.Values{}
+	for name, code := range o.Options {
+		query.Set(name, code)
+	}
+	return query.Encode()
+}
+
+// checkSingleObservation checks the observation of the pipeline, which is the
+// same before and after publishing
+func checkSingleObservation(observationsResource *httpexpect.Object, p *Pipeline, edition, version string) {
+	for _, d := range p.V4File.Header.Dimensions {
+		code := p.Observation.Options[d.Name]
+		option := observationsResource.Value("dimensions").Object().Value(d.Name).Object().Value("option").Object()
+		option.Value("href").String().Match("/code-lists/" + regexp.QuoteMeta(d.CodeListID) + "/codes/" + regexp.QuoteMeta(code) + "$")
+		option.Value("id").Equal(code)
+	}
+	observationsResource.Value("limit").Equal(10000)
+	observationsResource.Value("links").Object().Value("dataset_metadata").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/" + edition + "/versions/" + version + "/metadata$")
+	observationsResource.Value("links").Object().Value("self").Object().Value("href").String().Match(".+/datasets/" + datasetName + "/editions/" + edition + "/versions/" + version + "/observations\\?" + regexp.QuoteMeta(observationQuery(p.Observation)) + "$")
+	observationsResource.Value("links").Object().Value("version").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/" + edition + "/versions/" + version + "$")
+	observationsResource.Value("links").Object().Value("version").Object().Value("id").Equal(version)
+	observationsResource.Value("observations").Array().Length().Equal(1)
+	observationsResource.Value("observations").Array().Element(0).Object().Value("observation").Equal(p.Observation.Value)
+	observationsResource.Value("offset").Equal(0)
+	observationsResource.Value("total_observations").Equal(1)
+	observationsResource.Value("unit_of_measure").Equal("Pounds Sterling")
+}
+
+// teardownFilter removes a filter blueprint and its output, failing the stage
+// if they cannot be removed
+func teardownFilter(t *testing.T, filterBlueprintID, filterOutputID string) {
+	filterBlueprint := &mongo.Doc{
+		Database:   cfg.MongoFiltersDB,
+		Collection: "filters",
+		Key:        "filter_id",
+		Value:      filterBlueprintID,
+	}
+
+	filterOutput := &mongo.Doc{
+		Database:   cfg.MongoFiltersDB,
+		Collection: "filterOutputs",
+		Key:        "filter_id",
+		Value:      filterOutputID,
+	}
 
-		if !hasRemovedAllResources {
+	if err := mongo.Teardown(filterBlueprint, filterOutput); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("failed to remove filter output resource", err, log.Data{"filter_output_id": filterOutputID})
 			t.FailNow()
 		}
-	})
+	}
 }
 
 func testFileDownload(url string, expectedSize int, isPublished bool) {
@@ -836,9 +1046,9 @@ func testFileDownload(url string, expectedSize int, isPublished bool) {
 	So(actualFileSize, ShouldEqual, expectedSize)
 }
 
-func testFiltering(t *testing.T, filterAPI *httpexpect.Expect, instanceID string, isPublished bool) (string, string) {
+func testFiltering(t *testing.T, filterAPI *httpexpect.Expect, instanceID, edition, version string, isPublished bool) (string, string) {
 
-	json := GetValidPOSTCreateFilterJSON(datasetName, "2017", "1")
+	json := GetValidPOSTCreateFilterJSON(datasetName, edition, version)
 
 	log.Info("creating filter", log.Data{"json": json})
 
@@ -866,8 +1076,8 @@ func testFiltering(t *testing.T, filterAPI *httpexpect.Expect, instanceID string
 	filterBlueprintResponse.Value("dimensions").Array().Element(2).Object().Value("options").Array().Length().Equal(1)
 	filterBlueprintResponse.Value("links").Object().Value("dimensions").Object().Value("href").String().Match("/filters/" + filterBlueprintID + "/dimensions$")
 	filterBlueprintResponse.Value("links").Object().Value("self").Object().Value("href").String().Match("/filters/(.+)$")
-	filterBlueprintResponse.Value("links").Object().Value("version").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/2017/versions/1$")
-	filterBlueprintResponse.Value("links").Object().Value("version").Object().Value("id").Equal("1")
+	filterBlueprintResponse.Value("links").Object().Value("version").Object().Value("href").String().Match("/datasets/" + datasetName + "/editions/" + edition + "/versions/" + version + "$")
+	filterBlueprintResponse.Value("links").Object().Value("version").Object().Value("id").Equal(version)
 	filterBlueprintResponse.Value("links").Object().Value("filter_output").Object().Value("href").String().Match("/filter-outputs/(.+)$")
 	filterBlueprintResponse.Value("links").Object().Value("filter_output").Object().Value("id").NotNull()
 	log.Info("filter response", log.Data{"resp": filterBlueprintResponse.Raw()})
@@ -884,18 +1094,20 @@ func testFiltering(t *testing.T, filterAPI *httpexpect.Expect, instanceID string
 	So(filterOutputResource.State, ShouldEqual, "created")
 
 	log.Info("waiting for filter to be set to complete", nil)
-	for i := 0; i < 10; i++ {
-
-		filterOutputResource, err = mongo.GetFilter(cfg.MongoFiltersDB, "filterOutputs", "filter_id", filterOutputID)
-		if err != nil {
-			log.ErrorC("Unable to retrieve filter output document", err, log.Data{"filter_output_id": filterOutputID})
-			t.FailNow()
-		}
-		if filterOutputResource.State == "completed" {
-			break
-		}
+	waiter := &mongo.Waiter{
+		Database:   cfg.MongoFiltersDB,
+		Collection: "filterOutputs",
+		Key:        "filter_id",
+		Value:      filterOutputID,
+		Watch:      cfg.MongoChangeStreams,
+		Interval:   pollInterval,
+		Deadline:   time.Now().Add(filterTimeout),
+	}
 
-		time.Sleep(time.Millisecond * 200)
+	// a filter output that is not completed in time fails the checks below
+	if err = waiter.Until(&filterOutputResource, func() bool { return filterOutputResource.State == "completed" }); err != nil && err != mongo.ErrWaitTimedOut {
+		log.ErrorC("Unable to retrieve filter output document", err, log.Data{"filter_output_id": filterOutputID})
+		t.FailNow()
 	}
 
 	So(filterOutputResource.FilterID, ShouldEqual, filterOutputID)
@@ -937,11 +1149,7 @@ func testFiltering(t *testing.T, filterAPI *httpexpect.Expect, instanceID string
 		t.Error()
 		t.FailNow()
 	}
-	filteredCSVReader := csv.NewReader(filteredCSVFile)
-	if err = checkFileRowCount(filteredCSVReader, 39); err != nil {
-		log.ErrorC("unable to check file row count", err, nil)
-		t.FailNow()
-	}
+	filteredCSV := readS3File(t, filteredCSVFile, filteredCSVFilename)
 
 	log.Debug("checking xlsx download",
 		log.Data{
@@ -975,15 +1183,10 @@ func testFiltering(t *testing.T, filterAPI *httpexpect.Expect, instanceID string
 		t.FailNow()
 	}
 	So(filteredXLSFile, ShouldNotBeEmpty)
-	filteredXLSFileSize, err := getS3FileSize(region, bucket, filteredXLSFilename, !isPublished)
-	if err != nil {
-		log.ErrorC("unable to extract size of filtered xls download in s3", err, log.Data{"filtered_xls_url": filteredXLSURL, "filtered_xls_filename": filteredXLSFilename})
-		t.FailNow()
-	}
 
-	minExpectedXLSFileSize := int64(7465)
-	maxExpectedXLSFileSize := int64(7469)
-	So(*filteredXLSFileSize, ShouldBeBetweenOrEqual, minExpectedXLSFileSize, maxExpectedXLSFileSize)
+	log.Info("Check the filtered csv and xlsx hold the rows of the v4 file the filter selects", nil)
+	filteredXLS := readS3File(t, filteredXLSFile, filteredXLSFilename)
+	checkFilterOutput(t, filterV4File(t, v4TestFile, validFilterDimensions), filteredCSV, filteredXLS)
 
 	expectedCSVSize, _ := strconv.Atoi(filterOutputResource.Downloads.CSV.Size)
 	testFileDownload(filterOutputResource.Downloads.CSV.HRef, expectedCSVSize, isPublished)
@@ -995,6 +1198,64 @@ func testFiltering(t *testing.T, filterAPI *httpexpect.Expect, instanceID string
 
 }
 
+// readS3File reads the whole of a file got from s3 and closes it
+func readS3File(t *testing.T, file io.ReadCloser, filename string) []byte {
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.ErrorC("Unable to close s3 file", err, log.Data{"filename": filename})
+		}
+	}()
+
+	b, err := ioutil.ReadAll(file)
+	if err != nil {
+		log.ErrorC("Unable to read s3 file", err, log.Data{"filename": filename})
+		t.FailNow()
+	}
+
+	return b
+}
+
+// filterV4File returns the rows of the v4 file a filter of the given
+// dimensions is expected to output
+func filterV4File(t *testing.T, filename string, dimensions []downloads.FilterDimension) *downloads.Expected {
+	expected, err := downloads.Filter(openV4File(t, filename), dimensions)
+	if err != nil {
+		log.ErrorC("Unable to filter v4 file", err, log.Data{"v4_file": filename, "dimensions": dimensions})
+		t.FailNow()
+	}
+
+	return expected
+}
+
+// checkFilterOutput compares the csv and xlsx output of a filter row by row
+// with the rows it is expected to output, returning the result of each
+func checkFilterOutput(t *testing.T, expected *downloads.Expected, csvFile, xlsFile []byte) []*downloads.Result {
+	csvResult, err := downloads.CompareCSV(expected, bytes.NewReader(csvFile))
+	if err != nil {
+		log.ErrorC("Unable to read filtered csv", err, nil)
+		t.FailNow()
+	}
+
+	workbook, err := downloads.ReadXLSX(bytes.NewReader(xlsFile), int64(len(xlsFile)))
+	if err != nil {
+		log.ErrorC("Unable to read filtered xlsx", err, nil)
+		t.FailNow()
+	}
+	xlsResult := downloads.CompareXLSX(expected, workbook, downloads.XLSXExpectations{})
+
+	results := []*downloads.Result{csvResult, xlsResult}
+	for _, result := range results {
+		for _, mismatch := range result.Mismatches {
+			log.Info("filter output does not match", log.Data{"download": result.Download, "mismatch": mismatch.String()})
+		}
+
+		So(result.Mismatches, ShouldBeEmpty)
+		So(result.Rows, ShouldEqual, len(expected.Rows))
+	}
+
+	return results
+}
+
 func checkFileRowCount(csvReader *csv.Reader, expectedCount int64) error {
 	numberOfRows := int64(0)
 	// Iterate over file counting the number of rows that exist
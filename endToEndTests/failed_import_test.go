@@ -0,0 +1,266 @@
+package generateFiles
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/elasticsearch"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	importAPIModel "github.com/ONSdigital/dp-import-api/models"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// failedImport is a scenario where a file is expected to fail to import. The
+// file is written by changing the rows of the valid v4 file with edit, rows
+// being numbered from 1 for the header, and no file is written if edit is nil.
+// message is a part of the error the import reporter is expected to record.
+type failedImport struct {
+	name     string
+	filename string
+	message  string
+	edit     func(line int, row []string)
+	upload   func(t *testing.T, p *Pipeline)
+}
+
+// failedImportStages take a file expected to fail from import through to
+// checking nothing from it has been published
+func failedImportStages(message string) []Stage {
+	return []Stage{
+		{Name: "import", Timeout: importTimeout, Run: importV4File},
+		{Name: "failed", Timeout: observationsTimeout, Run: func(t *testing.T, p *Pipeline) { checkImportFailed(t, p, message) }},
+		{Name: "nothing-published", Run: checkNothingPublished},
+	}
+}
+
+func TestFailedImports(t *testing.T) {
+	if cfg.EndToEndInstanceID != "" || leftTestData {
+		t.Skip("end to end test data has been left in place, not importing further files over it")
+	}
+
+	// instances are created from the recipe rather than the file, so are
+	// checked against the valid file whatever the scenario
+	file, err := v4.ParseFile(v4TestFile)
+	if err != nil {
+		log.ErrorC("failed to parse v4 file, discontinue with test", err, log.Data{"v4_file": v4TestFile})
+		t.FailNow()
+	}
+
+	aggregate := file.Dimension("aggregate")
+
+	scenarios := []failedImport{
+		{
+			name:     "malformed-header",
+			filename: "v4MalformedHeader.csv",
+			message:  "invalid syntax",
+			edit: func(line int, row []string) {
+				if line == 1 {
+					row[0] = "V4_one"
+				}
+			},
+			upload: uploadV4File,
+		},
+		{
+			name:     "unknown-codes",
+			filename: "v4UnknownCodes.csv",
+			message:  "unknown-code-",
+			edit: func(line int, row []string) {
+				if line > 1 && line%10 == 0 {
+					row[aggregate.Column] = "unknown-code-" + strconv.Itoa(line)
+				}
+			},
+			upload: uploadV4File,
+		},
+		{
+			name:     "missing-from-s3",
+			filename: "v4MissingFromS3.csv",
+			message:  "NoSuchKey",
+			upload:   skipUpload,
+		},
+		{
+			name:     "missing-psk",
+			filename: "v4MissingPSK.csv",
+			message:  "key not found",
+			edit:     func(line int, row []string) {},
+			upload:   uploadV4FileWithoutPSK,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			if s.name == "missing-psk" && cfg.EncryptionDisabled {
+				t.Skip("files are not encrypted, so no psk is needed to import them")
+			}
+
+			p := &Pipeline{
+				Filename:      s.filename,
+				Recipe:        cpihRecipe,
+				V4File:        file,
+				Edition:       "2017",
+				Version:       1,
+				ExpectFailure: true,
+			}
+
+			if s.edit != nil {
+				if err := writeV4Variant(v4TestFile, s.filename, s.edit); err != nil {
+					log.ErrorC("failed to write v4 file, discontinue with test", err, log.Data{"v4_file": s.filename})
+					t.FailNow()
+				}
+				defer removeLocalFile(s.filename)
+			}
+
+			defer t.Run("teardown", func(t *testing.T) {
+				if p.InstanceID == "" {
+					t.Skip("no instance was created")
+				}
+				Convey("Remove the failed import", t, func() {
+					teardownEndToEnd(t, p)
+				})
+			})
+
+			stages := append([]Stage{{Name: "upload", Run: s.upload}}, failedImportStages(s.message)...)
+			p.Run(t, stages, "")
+		})
+	}
+}
+
+func skipUpload(t *testing.T, p *Pipeline) {
+	log.Info("not uploading file, so the import is unable to find it", log.Data{"v4_file": p.Filename})
+	p.Artifact("location", "s3://"+bucketName+"/"+p.Filename)
+}
+
+func uploadV4FileWithoutPSK(t *testing.T, p *Pipeline) {
+	if err := sendV4FileToAWSWithoutPSK(region, bucketName, p.Filename); err != nil {
+		log.ErrorC("failed to load in v4 to aws, discontinue with test", err, nil)
+		t.FailNow()
+	}
+
+	p.Artifact("location", "s3://"+bucketName+"/"+p.Filename)
+}
+
+// terminalStates are the instance states an import does not move on from, so
+// a failed import is waited on until it reaches one
+var terminalStates = map[string]bool{
+	"failed":            true,
+	"completed":         true,
+	"edition-confirmed": true,
+	"associated":        true,
+	"published":         true,
+}
+
+// checkImportFailed checks the job and instance are failed, and the import
+// reporter recorded an error containing message against the instance
+func checkImportFailed(t *testing.T, p *Pipeline, message string) {
+	instanceResource := p.WaitForInstance(t, "failed to get instance document to a state of failed", func(instanceResource mongo.Instance) bool {
+		return terminalStates[instanceResource.State]
+	})
+
+	So(instanceResource.State, ShouldEqual, "failed")
+
+	// the job is failed along with the instance, though not necessarily first
+	var jobResource importAPIModel.Job
+	p.WaitForDocument(t, "failed to get job document to a state of failed", cfg.MongoImportsDB, "imports", "id", p.JobID, &jobResource, func() bool {
+		return jobResource.State == "failed"
+	})
+
+	So(jobResource.State, ShouldEqual, "failed")
+
+	// the import reporter records the error as an event against the instance
+	So(instanceResource.Events, ShouldNotBeNil)
+
+	var errors []string
+	for _, event := range *instanceResource.Events {
+		if event.Type == "error" {
+			errors = append(errors, event.Message)
+			p.Artifact("error", event.Message)
+		}
+	}
+
+	So(errors, ShouldNotBeEmpty)
+	So(strings.Join(errors, "\n"), ShouldContainSubstring, message)
+}
+
+func checkNothingPublished(t *testing.T, p *Pipeline) {
+	instanceResource := p.GetInstance(t)
+
+	So(instanceResource.State, ShouldEqual, "failed")
+	So(instanceResource.Links.Version, ShouldBeNil)
+
+	if d := instanceResource.Downloads; d != nil {
+		for _, download := range []*mongo.DownloadObject{d.CSV, d.CSVW, d.XLS} {
+			if download != nil {
+				So(download.Public, ShouldBeEmpty)
+			}
+		}
+	}
+
+	// no search index is built for a failed import
+	_, status, err := elasticsearch.CallElastic(context.Background(), cfg.ElasticSearchAPIURL+"/"+p.InstanceID+"_aggregate", "GET", nil)
+	if err != nil && status == 0 {
+		log.ErrorC("Unable to call elasticsearch", err, log.Data{"instance_id": p.InstanceID})
+		t.FailNow()
+	}
+
+	So(status, ShouldEqual, http.StatusNotFound)
+}
+
+// writeV4Variant writes a copy of the source v4 file, passing each row to edit
+// to be changed before it is written
+func writeV4Variant(source, filename string, edit func(line int, row []string)) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.ErrorC("writeV4Variant", err, log.Data{"v4_file": source})
+		}
+	}()
+
+	out, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			log.ErrorC("writeV4Variant", err, log.Data{"v4_file": filename})
+		}
+	}()
+
+	reader := csv.NewReader(in)
+	reader.FieldsPerRecord = -1
+	writer := csv.NewWriter(out)
+
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		edit(line, row)
+		if err = writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func removeLocalFile(filename string) {
+	if err := os.Remove(filename); err != nil {
+		log.ErrorC("failed to remove local test file", err, log.Data{"v4_file": filename})
+	}
+}
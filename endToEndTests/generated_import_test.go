@@ -0,0 +1,309 @@
+package generateFiles
+
+import (
+	"encoding/csv"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/downloads"
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// generatedTimeout is how long a stage waiting on backend services has to
+// process a generated file, which can be far larger than the v4 test file
+const generatedTimeout = 10 * time.Minute
+
+// generatedImport is a scenario where a synthetic v4 file is generated and
+// imported with the CPIH recipe, so its dimensions take the names and code
+// lists of the v4 test file
+type generatedImport struct {
+	name     string
+	filename string
+	shape    v4.Shape
+}
+
+func TestGeneratedFileImports(t *testing.T) {
+	if cfg.EndToEndInstanceID != "" || leftTestData {
+		t.Skip("end to end test data has been left in place, not importing further files over it")
+	}
+
+	file, err := v4.ParseFile(v4TestFile)
+	if err != nil {
+		log.ErrorC("failed to parse v4 file, discontinue with test", err, log.Data{"v4_file": v4TestFile})
+		t.FailNow()
+	}
+
+	datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, "", "")
+	if err != nil {
+		log.ErrorC("unable to connect to neo4j", err, nil)
+		t.FailNow()
+	}
+
+	// the code lists of the recipe are replaced for each file, so are put
+	// back for the tests importing the v4 test file
+	defer func() {
+		if err := generateCPIHData(); err != nil {
+			log.ErrorC("unable to restore CPIH code lists", err, nil)
+			t.FailNow()
+		}
+		if err := datastore.Close(); err != nil {
+			log.ErrorC("unable to close connection to neo4j", err, nil)
+		}
+	}()
+
+	scenarios := []generatedImport{
+		{
+			name:     "large",
+			filename: "v4GeneratedLarge.csv",
+			shape:    v4.Shape{Options: []int{20, 10, 250}, Seed: 1},
+		},
+		{
+			name:     "sparse",
+			filename: "v4GeneratedSparse.csv",
+			shape:    v4.Shape{Options: []int{24, 5, 60}, Sparsity: 0.7, Seed: 2},
+		},
+		{
+			name:     "data-markings",
+			filename: "v4GeneratedDataMarkings.csv",
+			shape:    v4.Shape{Options: []int{12, 3, 40}, Markings: 1, MarkEvery: 7, Seed: 3},
+		},
+		{
+			name:     "unicode-labels",
+			filename: "v4GeneratedUnicodeLabels.csv",
+			shape:    v4.Shape{Options: []int{12, 3, 40}, UnicodeLabels: true, Seed: 4},
+		},
+		{
+			name:     "long-codes",
+			filename: "v4GeneratedLongCodes.csv",
+			shape:    v4.Shape{Options: []int{12, 3, 40}, CodeLength: 100, Seed: 5},
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			s.shape.Dimensions = file.Header.Dimensions
+
+			generated, err := v4.GenerateFile(s.filename, s.shape)
+			if err != nil {
+				log.ErrorC("failed to generate v4 file, discontinue with test", err, log.Data{"v4_file": s.filename})
+				t.FailNow()
+			}
+			defer removeLocalFile(s.filename)
+
+			hierarchy := setupGeneratedCodeLists(t, datastore, generated)
+
+			p := &Pipeline{
+				Filename:    s.filename,
+				Recipe:      cpihRecipe,
+				V4File:      generated,
+				Observation: readObservation(t, s.filename, generated.Header),
+				Edition:     "2017",
+				Version:     1,
+				NewDataset:  true,
+			}
+
+			defer t.Run("teardown", func(t *testing.T) {
+				if p.InstanceID == "" {
+					t.Skip("no instance was created")
+				}
+				Convey("Remove the generated import", t, func() {
+					teardownEndToEnd(t, p)
+				})
+			})
+
+			p.Run(t, generatedStages(hierarchy), "")
+		})
+	}
+}
+
+// generatedStages take a generated file from upload through to a published
+// version, checking its downloads and filter outputs against the file
+func generatedStages(hierarchy *neo4j.HierarchyDefinition) []Stage {
+	return []Stage{
+		{Name: "upload", Run: uploadV4File},
+		{Name: "import", Timeout: importTimeout, Run: importV4File},
+		{Name: "observations", Timeout: generatedTimeout, Run: checkObservationsImported},
+		{Name: "hierarchies", Timeout: generatedTimeout, Run: func(t *testing.T, p *Pipeline) { checkGeneratedHierarchy(t, p, hierarchy) }},
+		{Name: "search-index", Timeout: generatedTimeout, Run: checkSearchIndexBuilt},
+		{Name: "consistency", Timeout: generatedTimeout, Run: checkStoresConsistent},
+		{Name: "edition-confirm", Run: confirmEdition},
+		{Name: "associate", Run: associateVersion},
+		{Name: "export", Timeout: generatedTimeout, Run: checkFullDownloads},
+		{Name: "publish", Timeout: generatedTimeout, Run: publishVersion},
+		{Name: "search", Run: checkGeneratedSearch},
+		{Name: "download-content", Timeout: generatedTimeout, Run: checkDownloadContent},
+		{Name: "filter-content", Timeout: generatedTimeout, Run: checkGeneratedFilters},
+	}
+}
+
+// setupGeneratedCodeLists replaces the code lists of the recipe with the codes
+// of the generated file, and the generic hierarchy of the aggregate code list
+// with one where every other option is a child of the first. The hierarchy
+// loaded is returned.
+func setupGeneratedCodeLists(t *testing.T, datastore *neo4j.Datastore, generated *v4.File) *neo4j.HierarchyDefinition {
+	codeLists := neo4j.NewV4CodeLists(generated, "one-off")
+	fixture, err := neo4j.NewCodeListFixture(codeLists)
+	if err != nil {
+		log.ErrorC("unable to build code lists for generated file", err, nil)
+		t.FailNow()
+	}
+
+	var codeListIDs []string
+	for _, cl := range codeLists {
+		codeListIDs = append(codeListIDs, cl.ID)
+	}
+
+	if _, err = datastore.ReplaceCodeLists(fixture, codeListIDs...); err != nil {
+		log.ErrorC("unable to create code lists for generated file", err, log.Data{"code_lists": codeListIDs})
+		t.FailNow()
+	}
+
+	aggregate := generated.Dimension("aggregate")
+	hierarchy := &neo4j.HierarchyDefinition{CodeListID: aggregate.CodeListID}
+	for i, o := range aggregate.Options {
+		node := &neo4j.HierarchyNode{Code: o.Code, Label: o.Label}
+		if i > 0 {
+			node.Parent = aggregate.Options[0].Code
+		}
+		hierarchy.Nodes = append(hierarchy.Nodes, node)
+	}
+
+	if _, err = datastore.SetupGenericHierarchy(hierarchy); err != nil {
+		log.ErrorC("unable to create generic hierarchy for generated file", err, log.Data{"code_list_id": aggregate.CodeListID})
+		t.FailNow()
+	}
+
+	return hierarchy
+}
+
+// readObservation returns the first observation of the file that has not been
+// replaced by a data marking
+func readObservation(t *testing.T, filename string, header *v4.Header) *Observation {
+	file, err := os.Open(filename)
+	if err != nil {
+		log.ErrorC("Unable to open v4 file", err, log.Data{"v4_file": filename})
+		t.FailNow()
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.ErrorC("Unable to close v4 file", err, log.Data{"v4_file": filename})
+		}
+	}()
+
+	reader := csv.NewReader(file)
+	if _, err = reader.Read(); err != nil {
+		log.ErrorC("Unable to read v4 header", err, log.Data{"v4_file": filename})
+		t.FailNow()
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.ErrorC("Unable to read v4 file", err, log.Data{"v4_file": filename})
+			t.FailNow()
+		}
+
+		if row[0] == "" {
+			continue
+		}
+
+		observation := &Observation{Options: make(map[string]string), Value: row[0]}
+		for _, d := range header.Dimensions {
+			observation.Options[d.Name] = row[d.Column]
+		}
+		return observation
+	}
+
+	log.ErrorC("v4 file has no observations", nil, log.Data{"v4_file": filename})
+	t.FailNow()
+	return nil
+}
+
+// checkGeneratedHierarchy checks the hierarchy built for the aggregate
+// dimension is the generic hierarchy loaded for the generated file
+func checkGeneratedHierarchy(t *testing.T, p *Pipeline, hierarchy *neo4j.HierarchyDefinition) {
+	hierarchyAPI := httpexpect.New(t, cfg.HierarchyAPIURL)
+
+	waitForHierarchies(t, p)
+
+	root := hierarchy.Nodes[0]
+	response := hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension}", p.InstanceID, "aggregate").WithHeaders(headers).
+		Expect().Status(http.StatusOK).JSON().Object()
+
+	response.Value("has_data").Equal(true)
+	response.Value("label").Equal(root.Label)
+	response.Value("no_of_children").Equal(len(hierarchy.Nodes) - 1)
+	response.Value("links").Object().Value("code").Object().Value("href").Equal(cfg.CodeListAPIURL + "/code-lists/" + hierarchy.CodeListID + "/codes/" + root.Code)
+	response.Value("links").Object().Value("code").Object().Value("id").Equal(root.Code)
+	response.Value("children").Array().Length().Equal(len(hierarchy.Nodes) - 1)
+}
+
+// checkGeneratedSearch checks the last option of the aggregate dimension can
+// be found by its code, which no other code of the dimension starts with
+func checkGeneratedSearch(t *testing.T, p *Pipeline) {
+	searchAPI := httpexpect.New(t, cfg.SearchAPIURL)
+
+	aggregate := p.V4File.Dimension("aggregate")
+	option := aggregate.Options[len(aggregate.Options)-1]
+
+	response := searchAPI.GET("/search/datasets/{id}/editions/{edition}/versions/{version}/dimensions/{dimension}", datasetName, p.Edition, strconv.Itoa(p.Version), "aggregate").
+		WithQuery("q", option.Code).WithHeader(authorizationTokenHeader, authorizationToken).
+		Expect().Status(http.StatusOK).JSON().Object()
+
+	response.Value("count").Equal(1)
+
+	item := response.Value("items").Array().Element(0).Object()
+	item.Value("code").Equal(option.Code)
+	item.Value("label").Equal(option.Label)
+	item.Value("has_data").Equal(true)
+	item.Value("number_of_children").Equal(0)
+	item.Value("dimension_option_url").Equal(cfg.CodeListAPIURL + "/code-lists/" + aggregate.CodeListID + "/codes/" + option.Code)
+	item.Value("matches").Object().ContainsKey("code")
+}
+
+// checkGeneratedFilters compares the outputs of filters of the generated file
+// row by row with the rows of the file they select
+func checkGeneratedFilters(t *testing.T, p *Pipeline) {
+	filterAPI := httpexpect.New(t, cfg.FilterAPIURL)
+
+	timeOptions := p.V4File.Dimension("time").Options
+	aggregateOptions := p.V4File.Dimension("aggregate").Options
+	geographyOptions := p.V4File.Dimension("geography").Options
+
+	blueprints := []struct {
+		name       string
+		dimensions []downloads.FilterDimension
+	}{
+		{
+			name: "multiple-options",
+			dimensions: []downloads.FilterDimension{
+				{Name: "time", Options: []string{timeOptions[0].Code, timeOptions[len(timeOptions)-1].Code}},
+				{Name: "aggregate", Options: []string{aggregateOptions[0].Code, aggregateOptions[len(aggregateOptions)-1].Code}},
+			},
+		},
+		{
+			name: "unfiltered-dimensions",
+			dimensions: []downloads.FilterDimension{
+				{Name: "geography", Options: []string{geographyOptions[0].Code}},
+			},
+		},
+	}
+
+	for _, blueprint := range blueprints {
+		log.Info("Then the output of a filter holds exactly the rows of the generated file it selects", log.Data{"filter": blueprint.name, "v4_file": p.Filename})
+		checkFilterContentMatches(t, filterAPI, p, blueprint.name, blueprint.dimensions, filterV4File(t, p.Filename, blueprint.dimensions))
+	}
+}
@@ -20,6 +20,8 @@ const (
 
 	datasetName      = "cpih01"
 	genericHierarchy = "cpih1dim1aggid"
+	cpihRecipe       = "2943f3c5-c3f1-4a9a-aa6e-14d21c33524c"
+	v4TestFile       = "v4TestFile.csv"
 
 	florenceTokenHeader      = "X-Florence-Token"
 	florenceToken            = "85c718c3-9ba4-4f31-99bb-3e4eaabb2cc1"
@@ -62,6 +64,12 @@ func init() {
 		os.Exit(1)
 	}
 
+	// a resumed run carries on with the data left by the run that failed
+	if cfg.EndToEndInstanceID != "" {
+		log.Info("resuming from an existing instance, test data is left in place", log.Data{"instance_id": cfg.EndToEndInstanceID})
+		return
+	}
+
 	if err = mongo.DropDatabases(dropDatabases); err != nil {
 		log.ErrorC("failed to drop mongo databases", err, log.Data{"databases": dropDatabases})
 	}
@@ -173,8 +181,8 @@ func generateCPIHData() error {
 		return err
 	}
 
-	if err = datastore.CreateCPIHCodeList(); err != nil {
-		log.ErrorC("unable to create CPIH code list", err, nil)
+	if err = datastore.CreateCodeLists(v4TestFile); err != nil {
+		log.ErrorC("unable to create CPIH code lists", err, log.Data{"v4_file": v4TestFile})
 		return err
 	}
 
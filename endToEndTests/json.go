@@ -1,5 +1,11 @@
 package generateFiles
 
+import (
+	"encoding/json"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/downloads"
+)
+
 func createValidJobJSON(recipe, location string) string {
 	body := `{
 		"recipe": "` + recipe + `",
@@ -14,27 +20,38 @@ func createValidJobJSON(recipe, location string) string {
 }
 
 func GetValidPOSTCreateFilterJSON(datasetID, edition, version string) string {
+	return GetPOSTCreateFilterJSON(datasetID, edition, version, validFilterDimensions)
+}
+
+// validFilterDimensions filter to 38 aggregates for the UK in April 2005
+var validFilterDimensions = []downloads.FilterDimension{
+	{
+		Name:    "geography",
+		Options: []string{"K02000001"},
+	},
+	{
+		Name: "aggregate",
+		Options: []string{"cpih1dim1G100000", "cpih1dim1G110200", "cpih1dim1G120600", "cpih1dim1G30200", "cpih1dim1G40500", "cpih1dim1G40900", "cpih1dim1G50200", "cpih1dim1G50600", "cpih1dim1G90100", "cpih1dim1G50500", "cpih1dim1G60300", "cpih1dim1G70200", "cpih1dim1G70300", "cpih1dim1G110100", "cpih1dim1G120100", "cpih1dim1S40100", "cpih1dim1S40302", "cpih1dim1G20200", "cpih1dim1S50101", "cpih1dim1G60100", "cpih1dim1G60200", "cpih1dim1S10102", "cpih1dim1S10103",
+			"cpih1dim1S10109", "cpih1dim1S120301", "cpih1dim1S120400", "cpih1dim1S120302", "cpih1dim1S60300", "cpih1dim1S120504", "cpih1dim1S120503", "cpih1dim1S120700", "cpih1dim1S70102", "cpih1dim1S30200", "cpih1dim1S20102", "cpih1dim1S40403", "cpih1dim1S70302", "cpih1dim1S40503", "cpih1dim1S50200"},
+	},
+	{
+		Name:    "time",
+		Options: []string{"Apr-05"},
+	},
+}
+
+// GetPOSTCreateFilterJSON returns a filter blueprint of a version filtered to the given dimensions
+func GetPOSTCreateFilterJSON(datasetID, edition, version string, dimensions []downloads.FilterDimension) string {
+	// a list of names and string options always marshals
+	body, _ := json.Marshal(dimensions)
+
 	return `{
 	"dataset": {
 		"id": "` + datasetID + `",
 		"edition": "` + edition + `",
 		"version": ` + version + `
 	},
-	"dimensions": [
-  	{
-	  	"name": "geography",
-			"options": ["K02000001"]
-		},
-		{
-			"name": "aggregate",
-			"options": ["cpih1dim1G100000","cpih1dim1G110200","cpih1dim1G120600","cpih1dim1G30200","cpih1dim1G40500","cpih1dim1G40900","cpih1dim1G50200","cpih1dim1G50600","cpih1dim1G90100","cpih1dim1G50500","cpih1dim1G60300","cpih1dim1G70200","cpih1dim1G70300","cpih1dim1G110100","cpih1dim1G120100","cpih1dim1S40100","cpih1dim1S40302","cpih1dim1G20200","cpih1dim1S50101","cpih1dim1G60100","cpih1dim1G60200","cpih1dim1S10102","cpih1dim1S10103",
-				"cpih1dim1S10109","cpih1dim1S120301","cpih1dim1S120400","cpih1dim1S120302","cpih1dim1S60300","cpih1dim1S120504","cpih1dim1S120503","cpih1dim1S120700","cpih1dim1S70102","cpih1dim1S30200","cpih1dim1S20102","cpih1dim1S40403","cpih1dim1S70302","cpih1dim1S40503", "cpih1dim1S50200"]
-		},
-		{
-			"name": "time",
-			"options": ["Apr-05"]
-		}
-	]
+	"dimensions": ` + string(body) + `
 }`
 }
 
@@ -97,7 +114,8 @@ var validPOSTCreateDatasetJSON = `
 	"uri": "https://www.ons.gov.uk/economy/inflationandpriceindices/datasets/consumerpriceinflation"
 }`
 
-var validPUTInstanceMetadataJSON = `
+func createInstanceMetadataJSON(edition string) string {
+	return `
 {
 	"alerts": [
 	  {
@@ -106,7 +124,7 @@ var validPUTInstanceMetadataJSON = `
 			"type": "Correction"
 	  }
 	],
-	"edition": "2017",
+	"edition": "` + edition + `",
 	"latest_changes": [
 	  {
 		  "description": "change to the period frequency from quarterly to monthly",
@@ -129,6 +147,7 @@ var validPUTInstanceMetadataJSON = `
 		}
 	]
 }`
+}
 
 var validPUTUpdateVersionToAssociatedJSON = `
 {
@@ -0,0 +1,281 @@
+package generateFiles
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// Stage statuses reported in the pipeline summary
+const (
+	StagePassed  = "passed"
+	StageFailed  = "failed"
+	StageSkipped = "skipped"
+	StageResumed = "not run, resumed from a later stage"
+)
+
+// timeout is how long a stage has to complete unless it sets its own
+var timeout = time.Duration(30 * time.Second)
+
+// Timeouts of the stages that wait on backend services to process the file
+const (
+	importTimeout       = time.Minute
+	observationsTimeout = 2 * time.Minute
+	exportTimeout       = 2 * time.Minute
+	publishTimeout      = 2 * time.Minute
+)
+
+// pollInterval is how often a stage checks whether a backend service has
+// finished its part of the pipeline
+const pollInterval = 200 * time.Millisecond
+
+// filterTimeout is how long a filter output has to be completed
+const filterTimeout = 2 * time.Second
+
+// ErrUnknownStage is returned when a pipeline is asked to start from a stage it does not have
+var ErrUnknownStage = errors.New("unknown end to end stage")
+
+// ErrNoInstanceToResume is returned when a pipeline is started from a later
+// stage without an existing instance to resume
+var ErrNoInstanceToResume = errors.New("an instance id is needed to start from a later stage")
+
+// Stage is a named step of the end to end pipeline. Each stage runs as its own
+// subtest, with its own timeout, so a failure is reported against the stage
+// and the stages after it are skipped.
+type Stage struct {
+	Name    string
+	Timeout time.Duration
+	Run     func(t *testing.T, p *Pipeline)
+}
+
+// StageResult records how a stage went and anything it produced
+type StageResult struct {
+	Name      string
+	Status    string
+	Duration  time.Duration
+	Artifacts map[string]string
+}
+
+// Downloads holds the sizes of the full downloads generated for the instance
+type Downloads struct {
+	CSVSize  int
+	CSVWSize int
+	XLSSize  int
+}
+
+// Observation is an observation of the V4 file and the code of each of its
+// options, keyed by dimension name
+type Observation struct {
+	Options map[string]string
+	Value   string
+}
+
+// Pipeline holds the state shared by the stages of the end to end test. The
+// V4 file is imported as the given version of the edition, and NewDataset is
+// set when the dataset is created by the pipeline rather than already existing.
+// ExpectFailure is set when the file is expected to fail to import. Observation
+// is requested from the dataset API before and after the version is published.
+type Pipeline struct {
+	Filename      string
+	Recipe        string
+	V4File        *v4.File
+	Observation   *Observation
+	Edition       string
+	Version       int
+	NewDataset    bool
+	ExpectFailure bool
+	JobID         string
+	InstanceID    string
+	Downloads     Downloads
+
+	Results []*StageResult
+
+	current  *StageResult
+	deadline time.Time
+}
+
+// Run runs the stages in order, starting from the named stage or from the first
+// stage if the name is empty. Starting from a later stage resumes the pipeline
+// using the existing instance, which must already have passed the earlier stages.
+func (p *Pipeline) Run(t *testing.T, stages []Stage, from string) bool {
+	start := 0
+	if from != "" {
+		start = -1
+		for i, s := range stages {
+			if s.Name == from {
+				start = i
+			}
+		}
+
+		if start < 0 {
+			log.ErrorC("cannot start end to end test", ErrUnknownStage, log.Data{"stage": from})
+			t.FailNow()
+		}
+	}
+
+	if start > 0 {
+		if err := p.resume(); err != nil {
+			log.ErrorC("cannot resume end to end test", err, log.Data{"stage": from, "instance_id": p.InstanceID})
+			t.FailNow()
+		}
+	}
+
+	passed := true
+	for i, s := range stages {
+		result := &StageResult{Name: s.Name, Artifacts: make(map[string]string)}
+		p.Results = append(p.Results, result)
+
+		switch {
+		case i < start:
+			result.Status = StageResumed
+			continue
+		case !passed:
+			result.Status = StageSkipped
+			continue
+		}
+
+		stageTimeout := timeout
+		if s.Timeout > 0 {
+			stageTimeout = s.Timeout
+		}
+
+		p.current = result
+		began := time.Now()
+		p.deadline = began.Add(stageTimeout)
+
+		run := s.Run
+		passed = t.Run(s.Name, func(t *testing.T) {
+			Convey("End to end stage: "+s.Name, t, func() {
+				run(t, p)
+			})
+		})
+
+		result.Duration = time.Since(began)
+		result.Status = StagePassed
+		if !passed {
+			result.Status = StageFailed
+		}
+	}
+
+	p.current = nil
+	p.summarise()
+	return passed
+}
+
+// Artifact records something produced by the current stage, such as the id of
+// a resource, so it appears in the summary
+func (p *Pipeline) Artifact(key, value string) {
+	if p.current != nil {
+		p.current.Artifacts[key] = value
+	}
+}
+
+// WaitForDocument waits for the document with the key and value to be ready,
+// decoding it into result each time it changes, failing the stage if the stage
+// times out first. Changes are watched for with a mongo change stream when
+// change streams are enabled, rather than polled for.
+func (p *Pipeline) WaitForDocument(t *testing.T, description, database, collection, key, value string, result interface{}, ready func() bool) {
+	waiter := &mongo.Waiter{
+		Database:   database,
+		Collection: collection,
+		Key:        key,
+		Value:      value,
+		Watch:      cfg.MongoChangeStreams,
+		Interval:   pollInterval,
+		Deadline:   p.deadline,
+	}
+
+	if err := waiter.Until(result, ready); err != nil {
+		log.ErrorC("Timed out - "+description, err, log.Data{"instance_id": p.InstanceID, key: value, "deadline": p.deadline})
+		t.FailNow()
+	}
+}
+
+// WaitForInstance waits for the instance being imported to be ready, returning
+// the instance that was
+func (p *Pipeline) WaitForInstance(t *testing.T, description string, ready func(instance mongo.Instance) bool) mongo.Instance {
+	var instance mongo.Instance
+	p.WaitForDocument(t, description, cfg.MongoDB, "instances", "id", p.InstanceID, &instance, func() bool {
+		return ready(instance)
+	})
+	return instance
+}
+
+// GetInstance returns the instance being imported, failing the stage if it
+// cannot be found
+func (p *Pipeline) GetInstance(t *testing.T) mongo.Instance {
+	instance, err := mongo.GetInstance(cfg.MongoDB, "instances", "id", p.InstanceID)
+	if err != nil {
+		log.ErrorC("Unable to retrieve instance document", err, log.Data{"instance_id": p.InstanceID})
+		t.FailNow()
+	}
+	return instance
+}
+
+// resume fills in the state earlier stages would have shared from the
+// existing instance
+func (p *Pipeline) resume() error {
+	if p.InstanceID == "" {
+		return ErrNoInstanceToResume
+	}
+
+	instance, err := mongo.GetInstance(cfg.MongoDB, "instances", "id", p.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	if instance.Links.Job != nil {
+		p.JobID = instance.Links.Job.ID
+	}
+
+	if instance.Edition != "" {
+		p.Edition = instance.Edition
+		p.Version = instance.Version
+	}
+
+	if d := instance.Downloads; d != nil && d.CSV != nil && d.CSVW != nil && d.XLS != nil {
+		if p.Downloads.CSVSize, err = strconv.Atoi(d.CSV.Size); err != nil {
+			return err
+		}
+		if p.Downloads.CSVWSize, err = strconv.Atoi(d.CSVW.Size); err != nil {
+			return err
+		}
+		if p.Downloads.XLSSize, err = strconv.Atoi(d.XLS.Size); err != nil {
+			return err
+		}
+	}
+
+	log.Info("resuming end to end test", log.Data{"instance_id": p.InstanceID, "job_id": p.JobID})
+	return nil
+}
+
+// summarise logs how each stage went, with how to resume from a failed stage
+func (p *Pipeline) summarise() {
+	var total time.Duration
+	for _, r := range p.Results {
+		total += r.Duration
+
+		data := log.Data{"stage": r.Name, "status": r.Status}
+		if r.Status == StagePassed || r.Status == StageFailed {
+			data["duration"] = r.Duration.String()
+		}
+		if len(r.Artifacts) > 0 {
+			data["artifacts"] = r.Artifacts
+		}
+
+		if r.Status == StageFailed {
+			data["resume_with"] = "E2E_START_STAGE=" + r.Name + " E2E_INSTANCE_ID=" + p.InstanceID
+		}
+
+		log.Info("end to end stage", data)
+	}
+
+	log.Info("end to end pipeline", log.Data{"duration": total.String(), "instance_id": p.InstanceID, "job_id": p.JobID})
+}
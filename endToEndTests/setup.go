@@ -2,7 +2,6 @@ package generateFiles
 
 import (
 	"bytes"
-	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"io"
@@ -58,6 +57,16 @@ type Store struct {
 // TODO Once export services have been updated with encryption and decryption
 // remove decrypt boolean flag
 func sendV4FileToAWS(region, bucket, filename string, encrypt bool) error {
+	return putV4File(region, bucket, filename, encrypt, true)
+}
+
+// sendV4FileToAWSWithoutPSK encrypts and uploads the file without writing its
+// PSK to vault, so services are unable to decrypt it
+func sendV4FileToAWSWithoutPSK(region, bucket, filename string) error {
+	return putV4File(region, bucket, filename, true, false)
+}
+
+func putV4File(region, bucket, filename string, encrypt, storePSK bool) error {
 	config := aws.NewConfig().WithRegion(region)
 
 	store := &Store{
@@ -94,13 +103,15 @@ func sendV4FileToAWS(region, bucket, filename string, encrypt bool) error {
 		psk := createPSK()
 		pskStr := hex.EncodeToString(psk)
 
-		vaultPath := cfg.VaultPath + "/" + filename
-		vaultKey := "key"
+		if storePSK {
+			vaultPath := cfg.VaultPath + "/" + filename
+			vaultKey := "key"
 
-		err := vaultClient.WriteKey(vaultPath, vaultKey, pskStr)
-		if err != nil {
-			log.ErrorC("failed to write to vault", err, nil)
-			return err
+			err := vaultClient.WriteKey(vaultPath, vaultKey, pskStr)
+			if err != nil {
+				log.ErrorC("failed to write to vault", err, nil)
+				return err
+			}
 		}
 
 		_, err = client.PutObjectWithPSK(putObject, psk)
@@ -191,48 +202,6 @@ func getS3File(region, bucket, filename string, decrypt bool) (io.ReadCloser, er
 	return output.Body, nil
 }
 
-func getS3FileSize(region, bucket, filename string, decrypt bool) (*int64, error) {
-	config := aws.NewConfig().WithRegion(region)
-
-	store := &Store{
-		config: config,
-		bucket: bucket,
-	}
-
-	sess, err := session.NewSession(store.config)
-	if err != nil {
-		log.ErrorC("failed to create session", err, nil)
-		return nil, err
-	}
-
-	client, err := getClient(sess, decrypt)
-	if err != nil {
-		log.ErrorC("failed to create client", err, nil)
-		return nil, err
-	}
-
-	input := &s3.GetObjectInput{
-		Key:    aws.String(filename),
-		Bucket: aws.String(bucket),
-	}
-
-	ctx := context.Background()
-	result, err := client.GetObjectWithContext(ctx, input)
-	if err != nil {
-		log.ErrorC("failed to find file", err, nil)
-		return nil, err
-	}
-	defer func() {
-		if err := result.Body.Close(); err != nil {
-			log.ErrorC("getS3FileSize", err, nil)
-		}
-	}()
-
-	size := result.ContentLength
-
-	return size, nil
-}
-
 func deleteS3File(region, bucket, filename string) error {
 
 	config := aws.NewConfig().WithRegion(region)
@@ -0,0 +1,145 @@
+package generateFiles
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// TestPublishingNewVersionsAndEditions imports the v4 file three times, as the
+// first version of the 2017 edition, a second version of the same edition and
+// the first version of a new 2018 edition, then checks the dataset and
+// editions point at the latest versions and the first version is untouched.
+func TestPublishingNewVersionsAndEditions(t *testing.T) {
+	if cfg.EndToEndInstanceID != "" || leftTestData {
+		t.Skip("end to end test data has been left in place, not importing further versions over it")
+	}
+
+	file, err := v4.ParseFile(v4TestFile)
+	if err != nil {
+		log.ErrorC("failed to parse v4 file, discontinue with test", err, log.Data{"v4_file": v4TestFile})
+		t.FailNow()
+	}
+
+	pipelines := []*Pipeline{
+		{Filename: v4TestFile, Recipe: cpihRecipe, V4File: file, Observation: cpihObservation, Edition: "2017", Version: 1, NewDataset: true},
+		{Filename: v4TestFile, Recipe: cpihRecipe, V4File: file, Observation: cpihObservation, Edition: "2017", Version: 2},
+		{Filename: v4TestFile, Recipe: cpihRecipe, V4File: file, Observation: cpihObservation, Edition: "2018", Version: 1},
+	}
+	first := pipelines[0]
+
+	defer t.Run("teardown", func(t *testing.T) {
+		Convey("Remove all versions imported by the test", t, func() {
+			for _, p := range pipelines {
+				if p.InstanceID != "" {
+					teardownEndToEnd(t, p)
+				}
+			}
+		})
+	})
+
+	var firstVersion mongo.Version
+	for i, p := range pipelines {
+		name := p.Edition + "-v" + strconv.Itoa(p.Version)
+		if !t.Run(name, func(t *testing.T) { p.Run(t, importStages, "") }) {
+			t.FailNow()
+		}
+
+		if i == 0 {
+			// snapshot the first version once published, to check later
+			// imports leave it as it is
+			if firstVersion, err = mongo.GetVersion(cfg.MongoDB, "instances", "id", first.InstanceID); err != nil {
+				log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": first.InstanceID})
+				t.FailNow()
+			}
+		}
+	}
+
+	Convey("Given three versions across two editions have been published", t, func() {
+		datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
+		filterAPI := httpexpect.New(t, cfg.FilterAPIURL)
+
+		Convey("Then the dataset points at the first version of the new edition", func() {
+			datasetResource, err := mongo.GetDataset(cfg.MongoDB, "datasets", "_id", datasetName)
+			if err != nil {
+				log.ErrorC("Unable to retrieve dataset resource", err, log.Data{"dataset_id": datasetName})
+				t.FailNow()
+			}
+
+			latest := cfg.DatasetAPIURL + "/datasets/" + datasetName + "/editions/2018/versions/1"
+
+			So(datasetResource.Current, ShouldNotBeNil)
+			So(datasetResource.Current.State, ShouldEqual, "published")
+			So(datasetResource.Current.Links.LatestVersion.HRef, ShouldEqual, latest)
+			So(datasetResource.Next.State, ShouldEqual, "published")
+			So(datasetResource.Next.Links.LatestVersion.HRef, ShouldEqual, latest)
+		})
+
+		Convey("Then each edition points at its own latest version", func() {
+			checkLatestVersion(t, "2017", "2")
+			checkLatestVersion(t, "2018", "1")
+		})
+
+		Convey("Then the first version is unchanged by the later imports", func() {
+			version, err := mongo.GetVersion(cfg.MongoDB, "instances", "id", first.InstanceID)
+			if err != nil {
+				log.ErrorC("Unable to retrieve version resource", err, log.Data{"instance_id": first.InstanceID})
+				t.FailNow()
+			}
+
+			So(version.State, ShouldEqual, "published")
+			So(version.Edition, ShouldEqual, firstVersion.Edition)
+			So(version.Version, ShouldEqual, firstVersion.Version)
+			So(version.CollectionID, ShouldEqual, firstVersion.CollectionID)
+			So(version.ReleaseDate, ShouldEqual, firstVersion.ReleaseDate)
+			So(version.Links, ShouldResemble, firstVersion.Links)
+			So(version.Downloads, ShouldResemble, firstVersion.Downloads)
+		})
+
+		Convey("When an authorised PUT request is made to update the first version", func() {
+			Convey("Then it is forbidden as the version has been published", func() {
+				datasetAPI.PUT("/datasets/{id}/editions/{edition}/versions/{version}", datasetName, "2017", "1").
+					WithHeaders(headers).WithBytes([]byte(`{"state":"edition-confirmed"}`)).
+					Expect().Status(http.StatusForbidden).
+					Body().Contains("unable to update version as it has been published")
+			})
+		})
+
+		Convey("Then the full downloads of the first version can still be downloaded", func() {
+			testFileDownload(firstVersion.Downloads.CSV.URL, first.Downloads.CSVSize, true)
+			testFileDownload(firstVersion.Downloads.CSVW.URL, first.Downloads.CSVWSize, true)
+			testFileDownload(firstVersion.Downloads.XLS.URL, first.Downloads.XLSSize, true)
+		})
+
+		Convey("Then the first version can still be filtered", func() {
+			filterBlueprintID, filterOutputID := testFiltering(t, filterAPI, first.InstanceID, "2017", "1", true)
+			teardownFilter(t, filterBlueprintID, filterOutputID)
+		})
+	})
+}
+
+// checkLatestVersion checks the current and next edition documents are
+// published and link to the given version as the latest
+func checkLatestVersion(t *testing.T, edition, version string) {
+	self := cfg.DatasetAPIURL + "/datasets/" + datasetName + "/editions/" + edition
+
+	editionResource, err := mongo.GetEdition(cfg.MongoDB, "editions", "current.links.self.href", self)
+	if err != nil {
+		log.ErrorC("Unable to retrieve edition resource", err, log.Data{"dataset_id": datasetName, "edition": edition})
+		t.FailNow()
+	}
+
+	So(editionResource.Current, ShouldNotBeNil)
+	So(editionResource.Current.State, ShouldEqual, "published")
+	So(editionResource.Current.Links.LatestVersion.ID, ShouldEqual, version)
+	So(editionResource.Current.Links.LatestVersion.HRef, ShouldEqual, self+"/versions/"+version)
+	So(editionResource.Next.State, ShouldEqual, "published")
+	So(editionResource.Next.Links.LatestVersion.ID, ShouldEqual, version)
+}
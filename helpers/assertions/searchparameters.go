@@ -0,0 +1,36 @@
+// Package assertions wraps checks shared by the API tests in goconvey
+// assertions, so they must be called from within a Convey block
+package assertions
+
+import (
+	"net/http"
+
+	"github.com/ONSdigital/dp-api-tests/helpers"
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// CheckSearchParameterCase sends the request with the query parameters of the
+// case and checks the status code, error body or result window
+func CheckSearchParameterCase(request *httpexpect.Request, c *helpers.SearchParameterCase) {
+	for key, value := range c.Query {
+		request = request.WithQuery(key, value)
+	}
+
+	response := request.Expect()
+
+	if c.Status != http.StatusOK {
+		Convey("Then the response returns the expected error status and message", func() {
+			response.Status(c.Status).Body().Contains(c.Body + "\n")
+		})
+		return
+	}
+
+	Convey("Then the response returns the expected window of results with a status ok (200)", func() {
+		body := response.Status(http.StatusOK).JSON().Object()
+		body.Value("limit").Equal(c.Limit)
+		body.Value("offset").Equal(c.Offset)
+		body.Value("count").Equal(c.Count)
+		body.Value("items").Array().Length().Equal(c.Count)
+	})
+}
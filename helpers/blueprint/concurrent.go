@@ -0,0 +1,224 @@
+package blueprint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ONSdigital/go-ns/log"
+	"github.com/ONSdigital/go-ns/rchttp"
+)
+
+// ErrNotConcurrent is returned for an operation the harness does not send at
+// the same time as others, as its effect depends on the order it is applied in
+var ErrNotConcurrent = errors.New("operation cannot be sent concurrently")
+
+// Outcome is the response to an operation sent at the same time as others.
+// FilterOutputID is set when a submit creates a filter output.
+type Outcome struct {
+	Operation      Operation
+	Status         int
+	Body           string
+	FilterOutputID string
+	Err            error
+}
+
+// Succeeded returns whether the filter API accepted the operation
+func (o Outcome) Succeeded() bool {
+	return o.Err == nil && o.Status >= 200 && o.Status < 300
+}
+
+// Harness sends operations against one filter blueprint all at once, to find
+// updates the filter API loses or applies twice when requests race
+type Harness struct {
+	FilterAPIURL string
+	Headers      map[string]string
+}
+
+// ConcurrentOperations returns operations that can be sent together against a
+// blueprint with the given dimensions: each option of the instance not chosen
+// is added, and each option chosen is removed, by repeats requests apiece,
+// along with submits puts of the blueprint as submitted. Adds and removes are
+// of different options, so the blueprint they should leave does not depend on
+// the order the filter API applies them.
+func ConcurrentOperations(initial []Dimension, options map[string][]string, repeats, submits int) []Operation {
+	var operations []Operation
+
+	for _, d := range initial {
+		for _, option := range options[d.Name] {
+			kind := AddOption
+			if contains(d.Options, option) {
+				kind = RemoveOption
+			}
+
+			for i := 0; i < repeats; i++ {
+				operations = append(operations, Operation{Kind: kind, Dimension: d.Name, Options: []string{option}})
+			}
+		}
+	}
+
+	for i := 0; i < submits; i++ {
+		operations = append(operations, Operation{Kind: Submit})
+	}
+
+	return operations
+}
+
+// Run sends every operation against the blueprint at the same moment and
+// returns the outcome of each, in the order the operations were given
+func (h *Harness) Run(ctx context.Context, filterBlueprintID string, operations []Operation) []Outcome {
+	// retries would send an operation again, hiding what the first attempt did
+	client := *rchttp.DefaultClient
+	client.MaxRetries = 0
+
+	outcomes := make([]Outcome, len(operations))
+	start := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i, o := range operations {
+		wg.Add(1)
+		go func(i int, o Operation) {
+			defer wg.Done()
+			<-start
+			outcomes[i] = h.send(ctx, &client, filterBlueprintID, o)
+		}(i, o)
+	}
+
+	close(start)
+	wg.Wait()
+
+	return outcomes
+}
+
+func (h *Harness) send(ctx context.Context, client *rchttp.Client, filterBlueprintID string, o Operation) Outcome {
+	outcome := Outcome{Operation: o}
+
+	blueprintURL := h.FilterAPIURL + "/filters/" + url.PathEscape(filterBlueprintID)
+	var method, path string
+	body := []byte(`{}`)
+
+	switch o.Kind {
+	case AddOption, RemoveOption:
+		method = http.MethodPost
+		if o.Kind == RemoveOption {
+			method = http.MethodDelete
+		}
+		path = blueprintURL + "/dimensions/" + url.PathEscape(o.Dimension) + "/options/" + url.PathEscape(o.Options[0])
+		body = nil
+	case Submit, Resubmit:
+		method = http.MethodPut
+		path = blueprintURL + "?submitted=true"
+	default:
+		outcome.Err = ErrNotConcurrent
+		return outcome
+	}
+
+	req, err := http.NewRequest(method, path, bytes.NewReader(body))
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	for name, value := range h.Headers {
+		req.Header.Set(name, value)
+	}
+
+	response, err := client.Do(ctx, req)
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			log.ErrorC("concurrent filter blueprint request body", err, log.Data{"operation": o.String()})
+		}
+	}()
+
+	outcome.Status = response.StatusCode
+	b, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		outcome.Err = err
+		return outcome
+	}
+	outcome.Body = string(b)
+
+	if outcome.Succeeded() && (o.Kind == Submit || o.Kind == Resubmit) {
+		var submitted struct {
+			Links struct {
+				FilterOutput struct {
+					ID string `json:"id"`
+				} `json:"filter_output"`
+			} `json:"links"`
+		}
+		if err = json.Unmarshal(b, &submitted); err != nil {
+			outcome.Err = err
+			return outcome
+		}
+		outcome.FilterOutputID = submitted.Links.FilterOutput.ID
+	}
+
+	return outcome
+}
+
+// CheckConcurrent compares the dimensions a blueprint was left with against
+// what the successful operations should have left. A missing option that a
+// successful request added, or a present option a successful request removed,
+// is a lost update. Repeated dimensions or options, and dimensions without a
+// name, are corruption of the dimensions array. Operations that failed are
+// expected to have changed nothing.
+func CheckConcurrent(initial []Dimension, outcomes []Outcome, final []Dimension) []Difference {
+	model := NewModel(initial)
+	for _, o := range outcomes {
+		if o.Succeeded() {
+			model.Apply(o.Operation)
+		}
+	}
+
+	var differences []Difference
+	for i, d := range final {
+		if d.Name == "" {
+			differences = append(differences, Difference{"dimensions[" + strconv.Itoa(i) + "]", "dimension has no name"})
+		}
+	}
+
+	return append(differences, model.Compare(final)...)
+}
+
+// StatusCounts returns the number of outcomes of each kind of operation with
+// each status, a status of 0 being a request that got no response
+func StatusCounts(outcomes []Outcome) map[string]map[int]int {
+	counts := make(map[string]map[int]int)
+	for _, o := range outcomes {
+		if counts[o.Operation.Kind] == nil {
+			counts[o.Operation.Kind] = make(map[int]int)
+		}
+		counts[o.Operation.Kind][o.Status]++
+	}
+	return counts
+}
+
+// Failed returns the outcomes of the operations the filter API did not
+// accept, ordered by status then operation
+func Failed(outcomes []Outcome) []Outcome {
+	var failed []Outcome
+	for _, o := range outcomes {
+		if !o.Succeeded() {
+			failed = append(failed, o)
+		}
+	}
+
+	sort.SliceStable(failed, func(i, j int) bool {
+		if failed[i].Status != failed[j].Status {
+			return failed[i].Status < failed[j].Status
+		}
+		return failed[i].Operation.String() < failed[j].Operation.String()
+	})
+
+	return failed
+}
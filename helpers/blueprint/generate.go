@@ -0,0 +1,156 @@
+package blueprint
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+)
+
+// ErrNoOptions is returned when a generator has no dimension options to choose from
+var ErrNoOptions = errors.New("no dimension options to generate operations from")
+
+// Generator makes sequences of operations against a blueprint of an instance
+// with the given options for each dimension, chosen at random from Seed so a
+// failing sequence can be generated again. Every operation generated is one
+// the filter API should accept: a blueprint always keeps at least one
+// dimension, and each dimension at least one option, so it can be submitted.
+type Generator struct {
+	Options map[string][]string
+	Steps   int
+	Seed    int64
+}
+
+// Generate returns Steps operations against a blueprint created with the
+// given dimensions, ending with a submit or resubmit so the last state of the
+// blueprint is always submitted
+func (g *Generator) Generate(initial []Dimension) ([]Operation, error) {
+	var names []string
+	for name, options := range g.Options {
+		if len(options) > 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 || g.Steps < 1 {
+		return nil, ErrNoOptions
+	}
+
+	// map iteration is random, so sort for the seed to repeat the sequence
+	sort.Strings(names)
+
+	random := rand.New(rand.NewSource(g.Seed))
+	model := NewModel(initial)
+
+	var operations []Operation
+	for len(operations) < g.Steps-1 {
+		o, ok := g.operation(random, model, names)
+		if !ok {
+			continue
+		}
+		model.Apply(o)
+		operations = append(operations, o)
+	}
+
+	last := Operation{Kind: Submit}
+	if model.Submitted {
+		last.Kind = Resubmit
+	}
+
+	return append(operations, last), nil
+}
+
+// operation picks an operation at random, returning false if the operation
+// picked cannot be made against the blueprint as it is
+func (g *Generator) operation(random *rand.Rand, model *Model, names []string) (Operation, bool) {
+	switch random.Intn(7) {
+	case 0:
+		name := names[random.Intn(len(names))]
+		return Operation{Kind: AddDimension, Dimension: name, Options: subset(random, g.Options[name])}, true
+
+	case 1:
+		if len(model.Dimensions) < 2 {
+			return Operation{}, false
+		}
+		d := model.Dimensions[random.Intn(len(model.Dimensions))]
+		return Operation{Kind: RemoveDimension, Dimension: d.Name}, true
+
+	case 2:
+		d, ok := g.pick(random, model)
+		if !ok {
+			return Operation{}, false
+		}
+		unchosen := remaining(g.Options[d.Name], d.Options)
+		if len(unchosen) == 0 {
+			return Operation{}, false
+		}
+		return Operation{Kind: AddOption, Dimension: d.Name, Options: []string{unchosen[random.Intn(len(unchosen))]}}, true
+
+	case 3:
+		d, ok := g.pick(random, model)
+		if !ok || len(d.Options) < 2 {
+			return Operation{}, false
+		}
+		return Operation{Kind: RemoveOption, Dimension: d.Name, Options: []string{d.Options[random.Intn(len(d.Options))]}}, true
+
+	case 4:
+		d, ok := g.pick(random, model)
+		if !ok {
+			return Operation{}, false
+		}
+		options := subset(random, g.Options[d.Name])
+		replaced := NewModel(model.Dimensions)
+		replaced.dimension(d.Name).Options = options
+		return Operation{Kind: ReplaceOptions, Dimension: d.Name, Options: options, Dimensions: replaced.Dimensions}, true
+
+	case 5:
+		// submit less often than the blueprint is changed
+		if random.Intn(3) > 0 {
+			return Operation{}, false
+		}
+		if model.Submitted {
+			return Operation{Kind: Resubmit}, true
+		}
+		return Operation{Kind: Submit}, true
+
+	default:
+		// add an option the dimension already has, which should change nothing
+		d, ok := g.pick(random, model)
+		if !ok || len(d.Options) == 0 {
+			return Operation{}, false
+		}
+		return Operation{Kind: AddOption, Dimension: d.Name, Options: []string{d.Options[random.Intn(len(d.Options))]}}, true
+	}
+}
+
+// pick returns a dimension of the blueprint that the instance has options for
+func (g *Generator) pick(random *rand.Rand, model *Model) (Dimension, bool) {
+	var dimensions []Dimension
+	for _, d := range model.Dimensions {
+		if len(g.Options[d.Name]) > 0 {
+			dimensions = append(dimensions, d)
+		}
+	}
+	if len(dimensions) == 0 {
+		return Dimension{}, false
+	}
+	return dimensions[random.Intn(len(dimensions))], true
+}
+
+// subset returns a non empty random selection of the options
+func subset(random *rand.Rand, options []string) []string {
+	var result []string
+	for _, i := range random.Perm(len(options))[:1+random.Intn(len(options))] {
+		result = append(result, options[i])
+	}
+	return result
+}
+
+// remaining returns the options not already chosen
+func remaining(options, chosen []string) []string {
+	var result []string
+	for _, option := range options {
+		if !contains(chosen, option) {
+			result = append(result, option)
+		}
+	}
+	return result
+}
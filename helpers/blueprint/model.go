@@ -0,0 +1,212 @@
+// Package blueprint models what a filter blueprint should contain after a
+// sequence of operations, generates sequences of operations to run against the
+// filter API from a seed, and sends operations concurrently to find updates
+// lost when requests race
+package blueprint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of operation made against a filter blueprint
+const (
+	AddDimension    = "add dimension"
+	RemoveDimension = "remove dimension"
+	AddOption       = "add option"
+	RemoveOption    = "remove option"
+	ReplaceOptions  = "replace options"
+	Submit          = "submit"
+	Resubmit        = "resubmit"
+)
+
+// Dimension is a dimension of a filter blueprint and its chosen options
+type Dimension struct {
+	Name    string   `json:"name"`
+	Options []string `json:"options"`
+}
+
+// Operation is a request made against a filter blueprint. AddDimension posts
+// the dimension with Options, replacing any options it had, and
+// RemoveDimension deletes it. AddOption and RemoveOption post and delete
+// Options[0] of the dimension. ReplaceOptions puts the blueprint with
+// Dimensions, in which the dimension has Options in place of those it had.
+// Submit and Resubmit put the blueprint as submitted, Resubmit once it has
+// already been submitted.
+type Operation struct {
+	Kind       string
+	Dimension  string
+	Options    []string
+	Dimensions []Dimension
+}
+
+func (o Operation) String() string {
+	switch o.Kind {
+	case Submit, Resubmit:
+		return o.Kind
+	case RemoveDimension:
+		return fmt.Sprintf("%s %s", o.Kind, o.Dimension)
+	}
+	return fmt.Sprintf("%s %s [%s]", o.Kind, o.Dimension, strings.Join(o.Options, ", "))
+}
+
+// Difference is a way a blueprint differs from the model of it
+type Difference struct {
+	Dimension string
+	Detail    string
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %s", d.Dimension, d.Detail)
+}
+
+// Model is what a filter blueprint should contain
+type Model struct {
+	Dimensions []Dimension
+	Submitted  bool
+}
+
+// NewModel returns a model of a blueprint created with the given dimensions
+func NewModel(dimensions []Dimension) *Model {
+	return &Model{Dimensions: copyDimensions(dimensions)}
+}
+
+// Apply updates the model with the effect of a successful operation
+func (m *Model) Apply(o Operation) {
+	switch o.Kind {
+	case AddDimension:
+		if d := m.dimension(o.Dimension); d != nil {
+			d.Options = distinct(o.Options)
+			return
+		}
+		m.Dimensions = append(m.Dimensions, Dimension{Name: o.Dimension, Options: distinct(o.Options)})
+
+	case RemoveDimension:
+		for i, d := range m.Dimensions {
+			if d.Name == o.Dimension {
+				m.Dimensions = append(m.Dimensions[:i], m.Dimensions[i+1:]...)
+				return
+			}
+		}
+
+	case AddOption:
+		if d := m.dimension(o.Dimension); d != nil && !contains(d.Options, o.Options[0]) {
+			d.Options = append(d.Options, o.Options[0])
+		}
+
+	case RemoveOption:
+		if d := m.dimension(o.Dimension); d != nil {
+			d.Options = remove(d.Options, o.Options[0])
+		}
+
+	case ReplaceOptions:
+		m.Dimensions = copyDimensions(o.Dimensions)
+
+	case Submit, Resubmit:
+		m.Submitted = true
+	}
+}
+
+// Compare returns the differences between the dimensions of a blueprint and
+// the model. Dimensions and options are compared in any order, and repeated
+// dimensions or options are differences.
+func (m *Model) Compare(actual []Dimension) []Difference {
+	var differences []Difference
+
+	seen := make(map[string]bool)
+	for _, a := range actual {
+		if seen[a.Name] {
+			differences = append(differences, Difference{a.Name, "dimension is repeated"})
+			continue
+		}
+		seen[a.Name] = true
+
+		expected := m.dimension(a.Name)
+		if expected == nil {
+			differences = append(differences, Difference{a.Name, "dimension is not expected"})
+			continue
+		}
+
+		differences = append(differences, compareOptions(a.Name, expected.Options, a.Options)...)
+	}
+
+	for _, d := range m.Dimensions {
+		if !seen[d.Name] {
+			differences = append(differences, Difference{d.Name, "dimension is missing"})
+		}
+	}
+
+	return differences
+}
+
+func compareOptions(dimension string, expected, actual []string) []Difference {
+	var differences []Difference
+
+	seen := make(map[string]bool)
+	for _, option := range actual {
+		if seen[option] {
+			differences = append(differences, Difference{dimension, fmt.Sprintf("option %q is repeated", option)})
+			continue
+		}
+		seen[option] = true
+
+		if !contains(expected, option) {
+			differences = append(differences, Difference{dimension, fmt.Sprintf("option %q is not expected", option)})
+		}
+	}
+
+	for _, option := range expected {
+		if !seen[option] {
+			differences = append(differences, Difference{dimension, fmt.Sprintf("option %q is missing", option)})
+		}
+	}
+
+	return differences
+}
+
+func (m *Model) dimension(name string) *Dimension {
+	for i := range m.Dimensions {
+		if m.Dimensions[i].Name == name {
+			return &m.Dimensions[i]
+		}
+	}
+	return nil
+}
+
+func copyDimensions(dimensions []Dimension) []Dimension {
+	result := make([]Dimension, len(dimensions))
+	for i, d := range dimensions {
+		result[i] = Dimension{Name: d.Name, Options: append([]string{}, d.Options...)}
+	}
+	return result
+}
+
+// distinct returns the options without repeats, in the order first given
+func distinct(options []string) []string {
+	result := []string{}
+	for _, option := range options {
+		if !contains(result, option) {
+			result = append(result, option)
+		}
+	}
+	return result
+}
+
+func contains(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(options []string, option string) []string {
+	result := []string{}
+	for _, o := range options {
+		if o != option {
+			result = append(result, o)
+		}
+	}
+	return result
+}
@@ -0,0 +1,279 @@
+// Package consistency checks the stores an imported instance is written to
+// agree with each other, rather than checking each store against fixed counts
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/elasticsearch"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// Names of the stores compared
+const (
+	StoreMongo         = "mongo"
+	StoreNeo4j         = "neo4j"
+	StoreElasticsearch = "elasticsearch"
+)
+
+// Names of the checks made between stores
+const (
+	CheckObservations     = "observation counts match"
+	CheckDimensionOptions = "dimension options match"
+	CheckNodeIDs          = "option node ids exist in the graph"
+	CheckSearchIndex      = "search index matches the hierarchy"
+)
+
+// Difference is a fact the stores disagree on. Values holds what each store
+// has for the fact, keyed by store and, where a store holds the fact more than
+// once, the field within the store.
+type Difference struct {
+	Check     string                 `json:"check"`
+	Dimension string                 `json:"dimension,omitempty"`
+	Key       string                 `json:"key,omitempty"`
+	Field     string                 `json:"field,omitempty"`
+	Values    map[string]interface{} `json:"values"`
+}
+
+func (d Difference) String() string {
+	var stores []string
+	for store := range d.Values {
+		stores = append(stores, store)
+	}
+	sort.Strings(stores)
+
+	var values []string
+	for _, store := range stores {
+		values = append(values, fmt.Sprintf("%s=%v", store, d.Values[store]))
+	}
+
+	subject := strings.TrimSpace(strings.Join([]string{d.Dimension, d.Key, d.Field}, " "))
+	return fmt.Sprintf("%s: %s: %s", d.Check, subject, strings.Join(values, ", "))
+}
+
+// Report is the outcome of verifying an instance
+type Report struct {
+	InstanceID  string       `json:"instance_id"`
+	Differences []Difference `json:"differences"`
+}
+
+// Consistent returns whether the stores agree on everything checked
+func (r *Report) Consistent() bool {
+	return len(r.Differences) == 0
+}
+
+func (r *Report) add(d Difference) {
+	r.Differences = append(r.Differences, d)
+}
+
+// Verifier compares what an import has written to mongo, neo4j and
+// elasticsearch for an instance
+type Verifier struct {
+	MongoDB          string
+	Neo4j            *neo4j.Datastore
+	ElasticsearchURL string
+}
+
+// Verify checks the stores agree on an instance. An error is only returned if
+// a store could not be read, disagreements are returned as differences. The
+// search index of every dimension with a search task is compared with its
+// hierarchy.
+func (v *Verifier) Verify(ctx context.Context, instanceID string) (*Report, error) {
+	report := &Report{InstanceID: instanceID}
+	logData := log.Data{"instance_id": instanceID}
+
+	instance, err := mongo.GetInstance(v.MongoDB, "instances", "id", instanceID)
+	if err != nil {
+		log.ErrorC("unable to retrieve instance", err, logData)
+		return nil, err
+	}
+
+	if err = v.checkObservations(report, instance); err != nil {
+		log.ErrorC("unable to check observations", err, logData)
+		return nil, err
+	}
+
+	if err = v.checkDimensionOptions(report, instanceID); err != nil {
+		log.ErrorC("unable to check dimension options", err, logData)
+		return nil, err
+	}
+
+	if instance.ImportTasks != nil {
+		for _, task := range instance.ImportTasks.SearchTasks {
+			if err = v.checkSearchIndex(ctx, report, instanceID, task.DimensionName); err != nil {
+				logData["dimension"] = task.DimensionName
+				log.ErrorC("unable to check search index", err, logData)
+				return nil, err
+			}
+		}
+	}
+
+	log.Info("verified instance across stores", log.Data{
+		"instance_id": instanceID,
+		"consistent":  report.Consistent(),
+		"differences": len(report.Differences),
+	})
+
+	return report, nil
+}
+
+// checkObservations compares the observations counted on the instance with the
+// observation nodes in the graph
+func (v *Verifier) checkObservations(report *Report, instance mongo.Instance) error {
+	count, err := v.Neo4j.CountObservations(instance.InstanceID)
+	if err != nil {
+		return err
+	}
+
+	var inserted int64
+	if instance.ImportTasks != nil && instance.ImportTasks.ImportObservations != nil {
+		inserted = instance.ImportTasks.ImportObservations.InsertedObservations
+	}
+
+	if instance.TotalObservations != count || inserted != count {
+		report.add(Difference{
+			Check: CheckObservations,
+			Values: map[string]interface{}{
+				StoreMongo + ".total_observations":    instance.TotalObservations,
+				StoreMongo + ".inserted_observations": inserted,
+				StoreNeo4j:                            count,
+			},
+		})
+	}
+
+	return nil
+}
+
+// checkDimensionOptions compares the options of each dimension in mongo with
+// the option nodes in the graph, and that each option's node id is the id of
+// its node
+func (v *Verifier) checkDimensionOptions(report *Report, instanceID string) error {
+	options, err := mongo.GetDimensionOptions(v.MongoDB, "dimension.options", "instance_id", instanceID)
+	if err != nil {
+		return err
+	}
+
+	nodeIDs, err := v.Neo4j.GetDimensionOptionNodeIDs(instanceID)
+	if err != nil {
+		return err
+	}
+
+	inMongo := make(map[string]map[string]bool)
+	for _, o := range options {
+		if inMongo[o.Name] == nil {
+			inMongo[o.Name] = make(map[string]bool)
+		}
+		inMongo[o.Name][o.Option] = true
+
+		id, ok := nodeIDs[o.Name][o.Option]
+		if ok && id != o.NodeID {
+			report.add(Difference{
+				Check:     CheckNodeIDs,
+				Dimension: o.Name,
+				Key:       o.Option,
+				Values:    map[string]interface{}{StoreMongo: o.NodeID, StoreNeo4j: id},
+			})
+		}
+	}
+
+	for _, dimension := range union(keys(inMongo), keys(nodeIDs)) {
+		for _, option := range union(keys(inMongo[dimension]), keys(nodeIDs[dimension])) {
+			_, inGraph := nodeIDs[dimension][option]
+			if inMongo[dimension][option] != inGraph {
+				report.add(Difference{
+					Check:     CheckDimensionOptions,
+					Dimension: dimension,
+					Key:       option,
+					Values:    map[string]interface{}{StoreMongo: inMongo[dimension][option], StoreNeo4j: inGraph},
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkSearchIndex compares the documents of the search index of a dimension
+// with the nodes of its hierarchy
+func (v *Verifier) checkSearchIndex(ctx context.Context, report *Report, instanceID, dimension string) error {
+	nodes, err := v.Neo4j.GetHierarchyNodes(instanceID, dimension)
+	if err != nil {
+		return err
+	}
+
+	documents, err := elasticsearch.GetDimensions(ctx, v.ElasticsearchURL+"/"+instanceID+"_"+dimension)
+	if err != nil {
+		return err
+	}
+
+	indexed := make(map[string]*elasticsearch.Dimension)
+	for _, d := range documents {
+		indexed[d.Code] = d
+	}
+
+	for _, code := range union(keys(nodes), keys(indexed)) {
+		node, inGraph := nodes[code]
+		document, inIndex := indexed[code]
+
+		if !inGraph || !inIndex {
+			report.add(Difference{
+				Check:     CheckSearchIndex,
+				Dimension: dimension,
+				Key:       code,
+				Values:    map[string]interface{}{StoreNeo4j: inGraph, StoreElasticsearch: inIndex},
+			})
+			continue
+		}
+
+		if node.Label != document.Label {
+			report.add(searchDifference(dimension, code, "label", node.Label, document.Label))
+		}
+		if node.NumberOfChildren != int64(document.NumberOfChildren) {
+			report.add(searchDifference(dimension, code, "number_of_children", node.NumberOfChildren, document.NumberOfChildren))
+		}
+		if node.HasData != document.HasData {
+			report.add(searchDifference(dimension, code, "has_data", node.HasData, document.HasData))
+		}
+	}
+
+	return nil
+}
+
+func searchDifference(dimension, code, field string, graph, index interface{}) Difference {
+	return Difference{
+		Check:     CheckSearchIndex,
+		Dimension: dimension,
+		Key:       code,
+		Field:     field,
+		Values:    map[string]interface{}{StoreNeo4j: graph, StoreElasticsearch: index},
+	}
+}
+
+// keys returns the keys of a map with string keys
+func keys(m interface{}) []string {
+	var result []string
+	for _, k := range reflect.ValueOf(m).MapKeys() {
+		result = append(result, k.String())
+	}
+	return result
+}
+
+// union returns the sorted, distinct strings of both lists
+func union(a, b []string) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, s := range append(a, b...) {
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
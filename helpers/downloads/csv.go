@@ -0,0 +1,62 @@
+package downloads
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// VerifyCSV checks the CSV download holds the header of the V4 file and every
+// row of the V4 file exactly once, in any order. An error is only returned if
+// either file cannot be read as CSV.
+func VerifyCSV(v4File, download io.Reader) (*Result, error) {
+	expected, err := ReadV4(v4File)
+	if err != nil {
+		return nil, err
+	}
+
+	return CompareCSV(expected, download)
+}
+
+// CompareCSV checks the CSV download holds the expected header and every
+// expected row exactly once, in any order. An error is only returned if the
+// download cannot be read as CSV.
+func CompareCSV(expected *Expected, download io.Reader) (*Result, error) {
+	source := newSourceRows(expected, asIs)
+	result := &Result{Download: "csv"}
+	reader := csv.NewReader(download)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		result.mismatch("header", "download is empty")
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	source.checkHeader(result, "header", header)
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Rows++
+		if !source.match(row) {
+			result.mismatch("row "+strconv.Itoa(line), "%q is not an expected row, or is repeated", row)
+		}
+	}
+
+	source.unmatched(result)
+	return result, nil
+}
+
+// Header reads the header row of a CSV download
+func Header(download io.Reader) ([]string, error) {
+	return csv.NewReader(download).Read()
+}
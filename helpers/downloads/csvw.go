@@ -0,0 +1,166 @@
+package downloads
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+)
+
+// csvwContext is the JSON-LD context every CSVW metadata document uses
+const csvwContext = "http://www.w3.org/ns/csvw"
+
+// CSVW is the part of a CSVW metadata document the checks are made against
+type CSVW struct {
+	Context     interface{} `json:"@context"`
+	URL         string      `json:"url"`
+	Title       string      `json:"dct:title"`
+	Dialect     *Dialect    `json:"dialect"`
+	TableSchema struct {
+		Columns []Column `json:"columns"`
+	} `json:"tableSchema"`
+}
+
+// Dialect describes how the CSV a CSVW document describes is formatted.
+// Missing values take the defaults of the CSVW specification.
+type Dialect struct {
+	Delimiter      *string `json:"delimiter"`
+	Header         *bool   `json:"header"`
+	HeaderRowCount *int    `json:"headerRowCount"`
+	Encoding       *string `json:"encoding"`
+}
+
+// Column describes a column of the CSV. Titles may be a single title or a
+// list of titles.
+type Column struct {
+	Name   string      `json:"name"`
+	Titles interface{} `json:"titles"`
+}
+
+// CSVWExpectations are what a CSVW download should describe: the CSV
+// download with the given URL and header, of the dataset with the given title
+type CSVWExpectations struct {
+	CSVURL string
+	Header []string
+	Title  string
+}
+
+// VerifyCSVW checks the CSVW download is JSON-LD describing the CSV download:
+// its columns match the CSV header, its url points at the CSV and its dialect
+// is that of the CSV. An error is only returned if the download cannot be read.
+func VerifyCSVW(download io.Reader, expected CSVWExpectations) (*Result, error) {
+	body, err := ioutil.ReadAll(download)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Download: "csvw"}
+
+	var metadata CSVW
+	if err = json.Unmarshal(body, &metadata); err != nil {
+		result.mismatch("document", "is not valid json: %s", err)
+		return result, nil
+	}
+
+	if !hasContext(metadata.Context) {
+		result.mismatch("@context", "%v does not include %s", metadata.Context, csvwContext)
+	}
+
+	if !samePath(metadata.URL, expected.CSVURL) {
+		result.mismatch("url", "%q does not point at the csv %q", metadata.URL, expected.CSVURL)
+	}
+
+	if expected.Title != "" && metadata.Title != expected.Title {
+		result.mismatch("dct:title", "%q is not the dataset title %q", metadata.Title, expected.Title)
+	}
+
+	checkDialect(result, metadata.Dialect)
+
+	columns := metadata.TableSchema.Columns
+	if len(columns) != len(expected.Header) {
+		result.mismatch("tableSchema.columns", "has %d columns, the csv has %d", len(columns), len(expected.Header))
+	}
+
+	for i, column := range columns {
+		result.Rows++
+		if i >= len(expected.Header) {
+			continue
+		}
+
+		location := fmt.Sprintf("tableSchema.columns[%d]", i)
+		if !hasTitle(column.Titles, expected.Header[i]) {
+			result.mismatch(location+".titles", "%v does not match csv column %q", column.Titles, expected.Header[i])
+		}
+	}
+
+	return result, nil
+}
+
+func checkDialect(result *Result, dialect *Dialect) {
+	// a missing dialect is the default, which matches the exported csv
+	if dialect == nil {
+		return
+	}
+
+	if dialect.Delimiter != nil && *dialect.Delimiter != "," {
+		result.mismatch("dialect.delimiter", "%q is not a comma", *dialect.Delimiter)
+	}
+	if dialect.Header != nil && !*dialect.Header {
+		result.mismatch("dialect.header", "is false but the csv has a header row")
+	}
+	if dialect.HeaderRowCount != nil && *dialect.HeaderRowCount != 1 {
+		result.mismatch("dialect.headerRowCount", "is %d but the csv has a single header row", *dialect.HeaderRowCount)
+	}
+	if dialect.Encoding != nil && *dialect.Encoding != "utf-8" {
+		result.mismatch("dialect.encoding", "%q is not utf-8", *dialect.Encoding)
+	}
+}
+
+// hasContext returns whether a JSON-LD context is, or is a list including, the CSVW context
+func hasContext(context interface{}) bool {
+	switch c := context.(type) {
+	case string:
+		return c == csvwContext
+	case []interface{}:
+		for _, entry := range c {
+			if s, ok := entry.(string); ok && s == csvwContext {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasTitle returns whether column titles, either a single title or a list of
+// titles, include the given title
+func hasTitle(titles interface{}, title string) bool {
+	switch t := titles.(type) {
+	case string:
+		return t == title
+	case []interface{}:
+		for _, entry := range t {
+			if s, ok := entry.(string); ok && s == title {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// samePath returns whether two urls are for files with the same name, as the
+// CSVW document may refer to the CSV by its public url or its download link
+func samePath(a, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil || ua.Path == "" {
+		return false
+	}
+
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	return path.Base(ua.Path) == path.Base(ub.Path)
+}
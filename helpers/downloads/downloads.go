@@ -0,0 +1,166 @@
+// Package downloads checks the content of the full and filtered downloads
+// exported for a version against the V4 file it was imported from, so that
+// export regressions are found from what the files hold rather than their size
+package downloads
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxMismatches is the most mismatches recorded for a download, so a broken
+// export of a large file does not produce a mismatch per row
+const maxMismatches = 50
+
+// Mismatch is a difference between a download and what was expected of it
+type Mismatch struct {
+	Location string
+	Detail   string
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s: %s", m.Location, m.Detail)
+}
+
+// Result is the outcome of verifying a download. Rows is the number of rows,
+// or for a CSVW document columns, checked and Truncated the number of
+// mismatches found beyond those recorded.
+type Result struct {
+	Download   string
+	Rows       int
+	Mismatches []Mismatch
+	Truncated  int
+}
+
+// Valid returns whether no mismatches were found
+func (r *Result) Valid() bool {
+	return len(r.Mismatches) == 0
+}
+
+func (r *Result) mismatch(location, format string, args ...interface{}) {
+	if len(r.Mismatches) >= maxMismatches {
+		r.Truncated++
+		return
+	}
+	r.Mismatches = append(r.Mismatches, Mismatch{Location: location, Detail: fmt.Sprintf(format, args...)})
+}
+
+// Row is a row of a V4 file, with the line of the file it is on
+type Row struct {
+	Line   int
+	Values []string
+}
+
+// Expected is the header and rows a download is expected to hold, the rows
+// in any order
+type Expected struct {
+	Header []string
+	Rows   []Row
+}
+
+// ReadV4 reads the header and every row of a V4 file
+func ReadV4(v4File io.Reader) (*Expected, error) {
+	reader := csv.NewReader(v4File)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	expected := &Expected{Header: header}
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return expected, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		expected.Rows = append(expected.Rows, Row{Line: line, Values: row})
+	}
+}
+
+// sourceRows holds the expected rows of a download, to be matched off against
+// the rows of the download in any order
+type sourceRows struct {
+	header []string
+	lines  map[string][]int
+	format func(value string) string
+}
+
+// newSourceRows keys the expected rows by their values after format is
+// applied to each
+func newSourceRows(expected *Expected, format func(value string) string) *sourceRows {
+	rows := &sourceRows{header: expected.Header, lines: make(map[string][]int), format: format}
+	for _, row := range expected.Rows {
+		key := rows.key(row.Values)
+		rows.lines[key] = append(rows.lines[key], row.Line)
+	}
+	return rows
+}
+
+func (s *sourceRows) key(row []string) string {
+	values := make([]string, len(row))
+	for i, value := range row {
+		values[i] = s.format(value)
+	}
+	return strings.Join(values, "\x00")
+}
+
+// match removes a row matching the given row, returning false if there was none
+func (s *sourceRows) match(row []string) bool {
+	key := s.key(row)
+	lines := s.lines[key]
+	if len(lines) == 0 {
+		return false
+	}
+
+	if len(lines) == 1 {
+		delete(s.lines, key)
+	} else {
+		s.lines[key] = lines[1:]
+	}
+	return true
+}
+
+// unmatched records a mismatch for every expected row not in the download,
+// located by its line in the V4 file
+func (s *sourceRows) unmatched(result *Result) {
+	var lines []int
+	for _, l := range s.lines {
+		lines = append(lines, l...)
+	}
+	sort.Ints(lines)
+
+	for _, line := range lines {
+		result.mismatch("v4 row "+strconv.Itoa(line), "missing from the download")
+	}
+}
+
+// checkHeader records a mismatch if the header of a download differs from the
+// header of the V4 file
+func (s *sourceRows) checkHeader(result *Result, location string, header []string) {
+	if s.key(header) != s.key(s.header) {
+		result.mismatch(location, "header %q does not match the v4 header %q", header, s.header)
+	}
+}
+
+// asIs leaves a value unchanged
+func asIs(value string) string {
+	return value
+}
+
+// asNumber formats a value that is a number in its shortest form, so numbers
+// read back from a spreadsheet compare equal to the text they were written from
+func asNumber(value string) string {
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return value
+}
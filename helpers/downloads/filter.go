@@ -0,0 +1,81 @@
+package downloads
+
+import (
+	"errors"
+	"io"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+)
+
+// ErrUnknownDimension is returned when a filter has a dimension the V4 file
+// does not have
+var ErrUnknownDimension = errors.New("dimension is not in the v4 file")
+
+// FilterDimension is a dimension of a filter blueprint and the options it is
+// filtered to
+type FilterDimension struct {
+	Name    string   `json:"name"`
+	Options []string `json:"options"`
+}
+
+// Filter returns the header and rows of the V4 file that a filter blueprint
+// with the given dimensions is expected to output. A row is kept if, for every
+// dimension with options, one of the options is either the code or the label
+// of the row for that dimension, as options such as time are labels. A
+// dimension not in the blueprint, or without options, is not filtered on. A
+// filter matching no rows returns the header alone.
+func Filter(v4File io.Reader, dimensions []FilterDimension) (*Expected, error) {
+	expected, err := ReadV4(v4File)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := v4.ParseHeader(expected.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for _, d := range header.Dimensions {
+		columns[d.Name] = d.Column
+	}
+
+	options := make(map[int]map[string]bool)
+	for _, d := range dimensions {
+		column, ok := columns[d.Name]
+		if !ok {
+			return nil, ErrUnknownDimension
+		}
+		if len(d.Options) == 0 {
+			continue
+		}
+
+		if options[column] == nil {
+			options[column] = make(map[string]bool)
+		}
+		for _, option := range d.Options {
+			options[column][option] = true
+		}
+	}
+
+	var rows []Row
+	for _, row := range expected.Rows {
+		if selected(row.Values, options) {
+			rows = append(rows, row)
+		}
+	}
+	expected.Rows = rows
+
+	return expected, nil
+}
+
+// selected returns whether the code or label of a row is one of the options
+// of every filtered dimension, keyed by the column of the dimension's code
+func selected(row []string, options map[int]map[string]bool) bool {
+	for column, values := range options {
+		if column+1 >= len(row) || (!values[row[column]] && !values[row[column+1]]) {
+			return false
+		}
+	}
+	return true
+}
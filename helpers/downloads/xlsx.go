@@ -0,0 +1,345 @@
+package downloads
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/ONSdigital/go-ns/log"
+)
+
+const (
+	workbookPath      = "xl/workbook.xml"
+	workbookRelsPath  = "xl/_rels/workbook.xml.rels"
+	sharedStringsPath = "xl/sharedStrings.xml"
+)
+
+// ErrInvalidXLSX is returned when a file is not a readable XLSX workbook
+var ErrInvalidXLSX = errors.New("invalid xlsx workbook")
+
+// Workbook holds the cell values of each sheet of an XLSX file as text
+type Workbook struct {
+	Sheets []*Sheet
+}
+
+// Sheet holds the rows of a sheet. Rows are padded so each cell is at the
+// index of its column, with empty strings for cells that are not set.
+type Sheet struct {
+	Name string
+	Rows [][]string
+}
+
+// Sheet returns the sheet with the given name, or nil if there is none
+func (w *Workbook) Sheet(name string) *Sheet {
+	for _, s := range w.Sheets {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// Contains returns whether any cell of the sheet holds the value
+func (s *Sheet) Contains(value string) bool {
+	for _, row := range s.Rows {
+		for _, cell := range row {
+			if strings.TrimSpace(cell) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText is text that is either plain or made up of runs of formatted text
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+
+	var text string
+	for _, r := range t.Runs {
+		text += r.Text
+	}
+	return text
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadXLSX reads the cell values of every sheet of an XLSX file, in the order
+// the sheets appear in the workbook
+func ReadXLSX(r io.ReaderAt, size int64) (*Workbook, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*zip.File)
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	var workbook xlsxWorkbook
+	if err = decodeXML(files, workbookPath, &workbook); err != nil {
+		return nil, err
+	}
+
+	var rels xlsxRelationships
+	if err = decodeXML(files, workbookRelsPath, &rels); err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]string)
+	for _, rel := range rels.Relationships {
+		target := strings.TrimPrefix(rel.Target, "/")
+		if !strings.HasPrefix(target, "xl/") {
+			target = path.Join("xl", target)
+		}
+		targets[rel.ID] = target
+	}
+
+	// shared strings are optional, a workbook of inline strings has none
+	var shared xlsxSharedStrings
+	if _, ok := files[sharedStringsPath]; ok {
+		if err = decodeXML(files, sharedStringsPath, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &Workbook{}
+	for _, s := range workbook.Sheets {
+		target, ok := targets[s.ID]
+		if !ok {
+			return nil, ErrInvalidXLSX
+		}
+
+		var sheet xlsxSheet
+		if err = decodeXML(files, target, &sheet); err != nil {
+			return nil, err
+		}
+
+		rows, err := sheetRows(sheet, shared)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Sheets = append(result.Sheets, &Sheet{Name: s.Name, Rows: rows})
+	}
+
+	return result, nil
+}
+
+// XLSXExpectations are what an XLSX download should hold: a data sheet with
+// the rows of the V4 file, and a metadata sheet holding each of the Metadata
+// values, such as the dataset title
+type XLSXExpectations struct {
+	Metadata []string
+}
+
+// VerifyXLSX checks the XLSX download has a data sheet with the header and
+// rows of the V4 file, and that the other sheets hold the dataset metadata.
+// The data sheet is the first sheet with a row matching the V4 header, rows
+// above which are taken to be a title. Numbers are compared by value, as they
+// are read back from the spreadsheet rather than the text they were written
+// from. An error is only returned if either file cannot be read.
+func VerifyXLSX(v4File io.Reader, workbook *Workbook, expected XLSXExpectations) (*Result, error) {
+	rows, err := ReadV4(v4File)
+	if err != nil {
+		return nil, err
+	}
+
+	return CompareXLSX(rows, workbook, expected), nil
+}
+
+// CompareXLSX checks the XLSX download has a data sheet with the expected
+// header and rows, found and compared as VerifyXLSX does, and other sheets
+// holding the dataset metadata
+func CompareXLSX(rows *Expected, workbook *Workbook, expected XLSXExpectations) *Result {
+	source := newSourceRows(rows, asNumber)
+	result := &Result{Download: "xlsx"}
+	headerKey := source.key(source.header)
+
+	var data *Sheet
+	var headerRow int
+	for _, s := range workbook.Sheets {
+		for i, row := range s.Rows {
+			if source.key(trimRow(row, len(source.header))) == headerKey {
+				data, headerRow = s, i
+				break
+			}
+		}
+		if data != nil {
+			break
+		}
+	}
+
+	if data == nil {
+		result.mismatch("workbook", "no sheet has the header %q", source.header)
+		return result
+	}
+
+	for i, row := range data.Rows[headerRow+1:] {
+		row = trimRow(row, len(source.header))
+		if isEmpty(row) {
+			continue
+		}
+
+		result.Rows++
+		if !source.match(row) {
+			location := fmt.Sprintf("sheet %s row %d", data.Name, headerRow+i+2)
+			result.mismatch(location, "%q is not an expected row, or is repeated", row)
+		}
+	}
+	source.unmatched(result)
+
+	for _, value := range expected.Metadata {
+		var found bool
+		for _, s := range workbook.Sheets {
+			if s != data && s.Contains(value) {
+				found = true
+			}
+		}
+
+		if !found {
+			result.mismatch("metadata", "no sheet other than %s holds %q", data.Name, value)
+		}
+	}
+
+	return result
+}
+
+func decodeXML(files map[string]*zip.File, name string, v interface{}) error {
+	f, ok := files[name]
+	if !ok {
+		return ErrInvalidXLSX
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.ErrorC("decodeXML", err, log.Data{"file": name})
+		}
+	}()
+
+	return xml.NewDecoder(r).Decode(v)
+}
+
+// sheetRows returns the text of each cell of a sheet, placed at the index of
+// its column and row. Rows and cells without a reference follow the previous.
+func sheetRows(sheet xlsxSheet, shared xlsxSharedStrings) ([][]string, error) {
+	var rows [][]string
+	for _, r := range sheet.Rows {
+		rowNumber := len(rows) + 1
+		if r.Number != 0 {
+			rowNumber = r.Number
+		}
+
+		var row []string
+		for _, c := range r.Cells {
+			column := len(row)
+			if c.Ref != "" {
+				var err error
+				if column, err = cellColumn(c.Ref); err != nil {
+					return nil, err
+				}
+			}
+
+			for len(row) <= column {
+				row = append(row, "")
+			}
+
+			switch c.Type {
+			case "s":
+				i, err := strconv.Atoi(c.Value)
+				if err != nil || i < 0 || i >= len(shared.Items) {
+					return nil, ErrInvalidXLSX
+				}
+				row[column] = shared.Items[i].String()
+			case "inlineStr":
+				row[column] = c.Inline.String()
+			default:
+				row[column] = c.Value
+			}
+		}
+
+		for len(rows) < rowNumber {
+			rows = append(rows, nil)
+		}
+		rows[rowNumber-1] = row
+	}
+	return rows, nil
+}
+
+// cellColumn returns the zero based column of a cell reference such as B3
+func cellColumn(ref string) (int, error) {
+	column := 0
+	i := 0
+	for ; i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z'; i++ {
+		column = column*26 + int(ref[i]-'A'+1)
+	}
+
+	if i == 0 {
+		return 0, ErrInvalidXLSX
+	}
+
+	return column - 1, nil
+}
+
+// trimRow pads or cuts a row to the given number of cells
+func trimRow(row []string, cells int) []string {
+	trimmed := make([]string, cells)
+	copy(trimmed, row)
+	return trimmed
+}
+
+func isEmpty(row []string) bool {
+	for _, cell := range row {
+		if cell != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,316 @@
+// Package hierarchy walks a whole hierarchy through the hierarchy API and
+// checks the structure returned is consistent at every node
+package hierarchy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/ONSdigital/go-ns/log"
+	"github.com/ONSdigital/go-ns/rchttp"
+)
+
+// Names of the invariants checked at each node
+const (
+	RuleNumberOfChildren = "no_of_children equals the number of children"
+	RuleChildSummary     = "child summary matches the child node"
+	RuleSelfLink         = "self link points to the node"
+	RuleBreadcrumbs      = "breadcrumbs point back to the parent"
+	RuleHasData          = "has_data matches the instance dimension options"
+	RuleUniqueCode       = "codes are unique"
+	RuleNoCycles         = "hierarchy contains no cycles"
+	RuleCodeLink         = "code link resolves in the code list API"
+)
+
+// ErrUnexpectedStatusCode is returned when the hierarchy API does not return a node
+var ErrUnexpectedStatusCode = errors.New("unexpected status code from hierarchy api")
+
+var client = rchttp.NewClient()
+
+// Link represents a link within a hierarchy API response
+type Link struct {
+	ID   string `json:"id,omitempty"`
+	HRef string `json:"href"`
+}
+
+// Element represents a child or breadcrumb of a hierarchy node
+type Element struct {
+	Label        string           `json:"label"`
+	NoOfChildren int64            `json:"no_of_children"`
+	HasData      bool             `json:"has_data"`
+	Links        map[string]*Link `json:"links"`
+}
+
+// Node represents a hierarchy node returned by the hierarchy API
+type Node struct {
+	Label        string           `json:"label"`
+	NoOfChildren int64            `json:"no_of_children"`
+	HasData      bool             `json:"has_data"`
+	Links        map[string]*Link `json:"links"`
+	Children     []*Element       `json:"children"`
+	Breadcrumbs  []*Element       `json:"breadcrumbs"`
+}
+
+// Code returns the code of the node from its code link
+func (n *Node) Code() string {
+	return linkID(n.Links, "code")
+}
+
+// Violation represents an invariant that does not hold at a node
+type Violation struct {
+	Code   string
+	Rule   string
+	Detail string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s: %s", v.Code, v.Rule, v.Detail)
+}
+
+// Result represents the outcome of walking a hierarchy
+type Result struct {
+	Nodes      int
+	Depth      int
+	Duration   time.Duration
+	Violations []Violation
+}
+
+// Validator walks a hierarchy from its root node, requesting every node
+// reached through the children of its parent
+type Validator struct {
+	HierarchyAPIURL string
+	Headers         map[string]string
+
+	// Options are the codes with data for the instance dimension, when nil
+	// has_data is not checked
+	Options map[string]bool
+
+	// CheckCodeLinks requests the code link of every node, which requires the
+	// code list to be available through the code list API
+	CheckCodeLinks bool
+
+	result    *Result
+	seen      map[string]bool
+	codeLinks map[string]int
+}
+
+// Validate walks the hierarchy of a dimension of an instance. An error is
+// only returned if a node could not be retrieved, invariants that do not
+// hold are returned as violations.
+func (v *Validator) Validate(ctx context.Context, instanceID, dimension string) (*Result, error) {
+	start := time.Now()
+	v.result = &Result{}
+	v.seen = make(map[string]bool)
+	v.codeLinks = make(map[string]int)
+
+	base := fmt.Sprintf("%s/hierarchies/%s/%s", v.HierarchyAPIURL, instanceID, dimension)
+
+	root, err := v.get(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = v.walk(ctx, base, base, root, nil, nil); err != nil {
+		return nil, err
+	}
+
+	v.result.Duration = time.Since(start)
+	log.Info("validated hierarchy", log.Data{
+		"instance_id": instanceID,
+		"dimension":   dimension,
+		"nodes":       v.result.Nodes,
+		"depth":       v.result.Depth,
+		"violations":  len(v.result.Violations),
+		"duration":    v.result.Duration.String(),
+	})
+
+	return v.result, nil
+}
+
+// walk checks the invariants of a node reached from the given parent, then
+// walks each of its children. path holds the codes from the root to the parent.
+func (v *Validator) walk(ctx context.Context, base, href string, node *Node, parent *Node, path []string) error {
+	code := node.Code()
+	v.result.Nodes++
+	if len(path)+1 > v.result.Depth {
+		v.result.Depth = len(path) + 1
+	}
+
+	for _, ancestor := range path {
+		if ancestor == code {
+			v.violation(code, RuleNoCycles, fmt.Sprintf("node is its own ancestor through %v", path))
+			return nil
+		}
+	}
+
+	if v.seen[code] {
+		v.violation(code, RuleUniqueCode, "node has been reached more than once")
+		return nil
+	}
+	v.seen[code] = true
+
+	if int64(len(node.Children)) != node.NoOfChildren {
+		v.violation(code, RuleNumberOfChildren, fmt.Sprintf("no_of_children is %d but there are %d children", node.NoOfChildren, len(node.Children)))
+	}
+
+	if self := linkHRef(node.Links, "self"); self != href {
+		v.violation(code, RuleSelfLink, fmt.Sprintf("self link is %s but the node was requested from %s", self, href))
+	}
+
+	v.checkBreadcrumbs(code, node, parent, len(path))
+
+	if v.Options != nil && node.HasData != v.Options[code] {
+		v.violation(code, RuleHasData, fmt.Sprintf("has_data is %t but the code is an option: %t", node.HasData, v.Options[code]))
+	}
+
+	if v.CheckCodeLinks {
+		if err := v.checkCodeLink(ctx, code, linkHRef(node.Links, "code")); err != nil {
+			return err
+		}
+	}
+
+	path = append(path, code)
+	for _, child := range node.Children {
+		childCode := linkID(child.Links, "code")
+		childHRef := base + "/" + childCode
+
+		if self := linkHRef(child.Links, "self"); self != childHRef {
+			v.violation(childCode, RuleSelfLink, fmt.Sprintf("child self link is %s but expected %s", self, childHRef))
+		}
+
+		childNode, err := v.get(ctx, childHRef)
+		if err != nil {
+			return err
+		}
+
+		if child.Label != childNode.Label || child.HasData != childNode.HasData || child.NoOfChildren != childNode.NoOfChildren {
+			v.violation(childCode, RuleChildSummary, fmt.Sprintf("child of %s is %+v but node is label: %s, has_data: %t, no_of_children: %d",
+				code, *child, childNode.Label, childNode.HasData, childNode.NoOfChildren))
+		}
+
+		// copy the path so siblings do not share the same backing array
+		childPath := make([]string, len(path))
+		copy(childPath, path)
+
+		if err = v.walk(ctx, base, childHRef, childNode, node, childPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkBreadcrumbs checks the first breadcrumb is the parent the node was
+// reached from, and that there is a breadcrumb for every ancestor
+func (v *Validator) checkBreadcrumbs(code string, node, parent *Node, depth int) {
+	if len(node.Breadcrumbs) != depth {
+		v.violation(code, RuleBreadcrumbs, fmt.Sprintf("node at depth %d has %d breadcrumbs", depth, len(node.Breadcrumbs)))
+	}
+
+	if parent == nil || len(node.Breadcrumbs) == 0 {
+		return
+	}
+
+	crumb := node.Breadcrumbs[0]
+	if linkHRef(crumb.Links, "self") != linkHRef(parent.Links, "self") || crumb.Label != parent.Label {
+		v.violation(code, RuleBreadcrumbs, fmt.Sprintf("first breadcrumb is %s but the node was reached from %s",
+			linkHRef(crumb.Links, "self"), linkHRef(parent.Links, "self")))
+	}
+}
+
+// checkCodeLink requests the code link of a node, each link is only requested once
+func (v *Validator) checkCodeLink(ctx context.Context, code, href string) error {
+	if href == "" {
+		v.violation(code, RuleCodeLink, "node has no code link")
+		return nil
+	}
+
+	status, ok := v.codeLinks[href]
+	if !ok {
+		_, s, err := v.call(ctx, href)
+		if err != nil {
+			return err
+		}
+		status = s
+		v.codeLinks[href] = status
+	}
+
+	if status != http.StatusOK {
+		v.violation(code, RuleCodeLink, fmt.Sprintf("%s returned status %d", href, status))
+	}
+
+	return nil
+}
+
+func (v *Validator) violation(code, rule, detail string) {
+	v.result.Violations = append(v.result.Violations, Violation{Code: code, Rule: rule, Detail: detail})
+}
+
+// get requests a single hierarchy node
+func (v *Validator) get(ctx context.Context, href string) (*Node, error) {
+	body, status, err := v.call(ctx, href)
+	if err != nil {
+		return nil, err
+	}
+
+	if status != http.StatusOK {
+		log.ErrorC("failed to get hierarchy node", ErrUnexpectedStatusCode, log.Data{"url": href, "status_code": status})
+		return nil, ErrUnexpectedStatusCode
+	}
+
+	var node Node
+	if err = json.Unmarshal(body, &node); err != nil {
+		log.ErrorC("unable to unmarshal hierarchy node", err, log.Data{"url": href})
+		return nil, err
+	}
+
+	return &node, nil
+}
+
+func (v *Validator) call(ctx context.Context, href string) ([]byte, int, error) {
+	req, err := http.NewRequest("GET", href, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for key, value := range v.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(ctx, req)
+	if err != nil {
+		log.ErrorC("failed to call api", err, log.Data{"url": href})
+		return nil, 0, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.ErrorC("hierarchy validator", err, log.Data{"url": href})
+		}
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+
+	return body, resp.StatusCode, nil
+}
+
+func linkHRef(links map[string]*Link, name string) string {
+	if link, ok := links[name]; ok && link != nil {
+		return link.HRef
+	}
+	return ""
+}
+
+func linkID(links map[string]*Link, name string) string {
+	if link, ok := links[name]; ok && link != nil {
+		return link.ID
+	}
+	return ""
+}
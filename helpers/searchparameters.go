@@ -0,0 +1,156 @@
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error messages returned by the search API for invalid query parameters
+const (
+	SearchErrEmptyTerm      = "empty search term"
+	SearchErrParsing        = "failed to parse query parameters"
+	SearchErrNegativeLimit  = "the limit cannot be negative"
+	SearchErrNegativeOffset = "the offset cannot be negative"
+	SearchErrMaximumOffset  = "the maximum offset has been reached, the offset cannot be more than %d"
+)
+
+// SearchDefaultLimit is the limit used by the search API when none is requested
+const SearchDefaultLimit = 20
+
+// SearchMaxResults is the default maximum number of results the search API
+// will page through
+const SearchMaxResults = 1000
+
+// SearchParameterCase represents a request to the dimension search endpoint
+// with the status code, error body or result window it is expected to return
+type SearchParameterCase struct {
+	Description string
+	Query       map[string]string
+	Status      int
+	Body        string
+	Limit       int
+	Offset      int
+	Count       int
+}
+
+// SearchParameterCases generates cases for the limit and offset boundaries of
+// the search API and for a variety of search terms. term is expected to match
+// exactly total documents, and maxResults is the maximum number of results
+// the search API is configured to page through.
+func SearchParameterCases(term string, total, maxResults int) []*SearchParameterCase {
+	var cases []*SearchParameterCase
+
+	limits := []int{0, 1, total - 1, total, total + 1, maxResults - 1, maxResults, maxResults + 1}
+	offsets := []int{0, 1, total - 1, total, total + 1, maxResults - 1, maxResults, maxResults + 1}
+
+	for _, limit := range limits {
+		cases = append(cases, windowCase(term, total, maxResults, fmt.Sprint(limit), "", limit, 0))
+	}
+
+	for _, offset := range offsets {
+		cases = append(cases, windowCase(term, total, maxResults, "", fmt.Sprint(offset), SearchDefaultLimit, offset))
+	}
+
+	for _, limit := range []int{1, total} {
+		for _, offset := range []int{1, total - 1, maxResults - limit, maxResults - limit + 1} {
+			cases = append(cases, windowCase(term, total, maxResults, fmt.Sprint(limit), fmt.Sprint(offset), limit, offset))
+		}
+	}
+
+	for _, value := range []string{"-1", "-1000"} {
+		cases = append(cases,
+			errorCase("a negative limit of "+value, map[string]string{"q": term, "limit": value}, http.StatusBadRequest, SearchErrNegativeLimit),
+			errorCase("a negative offset of "+value, map[string]string{"q": term, "offset": value}, http.StatusBadRequest, SearchErrNegativeOffset),
+		)
+	}
+
+	for _, value := range []string{"ten", "1.5", "1e3", "9999999999999999999999"} {
+		cases = append(cases,
+			errorCase("a limit that is not an integer of "+value, map[string]string{"q": term, "limit": value}, http.StatusBadRequest, SearchErrParsing),
+			errorCase("an offset that is not an integer of "+value, map[string]string{"q": term, "offset": value}, http.StatusBadRequest, SearchErrParsing),
+		)
+	}
+
+	cases = append(cases,
+		errorCase("an empty search term", map[string]string{"q": ""}, http.StatusBadRequest, SearchErrEmptyTerm),
+		errorCase("no search term", map[string]string{"limit": "10"}, http.StatusBadRequest, SearchErrEmptyTerm),
+	)
+
+	// terms that cannot match any document, but must still return an empty
+	// list of results rather than an error
+	noMatches := [][2]string{
+		{"a whitespace search term", "   "},
+		{"a tab and newline search term", "\t\n"},
+		{"a very long search term", strings.Repeat("x", 2000)},
+		{"a very long multi word search term", strings.Repeat("zzz ", 250)},
+		{"a wildcard search term", "*"},
+		{"a search term of quotes", `"""`},
+		{"a search term of brackets", "{}[]()"},
+		{"a search term of a backslash", `\`},
+		{"a search term containing html", "<script>alert(1)</script>"},
+		{"a search term of query operators", "NOT OR AND:"},
+		{"a search term containing unicode", "ünïcödé ☃"},
+		{"a search term containing percent encoding", "%20%00"},
+	}
+
+	for _, n := range noMatches {
+		cases = append(cases, &SearchParameterCase{
+			Description: n[0],
+			Query:       map[string]string{"q": n[1]},
+			Status:      http.StatusOK,
+			Limit:       SearchDefaultLimit,
+		})
+	}
+
+	return cases
+}
+
+// windowCase returns the case for a limit and offset, the search API reduces
+// the limit so the result window never goes beyond the maximum results
+func windowCase(term string, total, maxResults int, limitParam, offsetParam string, limit, offset int) *SearchParameterCase {
+	query := map[string]string{"q": term}
+	description := "a search term"
+	if limitParam != "" {
+		query["limit"] = limitParam
+		description += " with a limit of " + limitParam
+	}
+	if offsetParam != "" {
+		query["offset"] = offsetParam
+		description += " with an offset of " + offsetParam
+	}
+
+	if offset >= maxResults {
+		return errorCase(description, query, http.StatusBadRequest, fmt.Sprintf(SearchErrMaximumOffset, maxResults))
+	}
+
+	if limit+offset > maxResults {
+		limit = maxResults - offset
+	}
+
+	count := total - offset
+	if count > limit {
+		count = limit
+	}
+	if count < 0 {
+		count = 0
+	}
+
+	return &SearchParameterCase{
+		Description: description,
+		Query:       query,
+		Status:      http.StatusOK,
+		Limit:       limit,
+		Offset:      offset,
+		Count:       count,
+	}
+}
+
+func errorCase(description string, query map[string]string, status int, body string) *SearchParameterCase {
+	return &SearchParameterCase{
+		Description: description,
+		Query:       query,
+		Status:      status,
+		Body:        body,
+	}
+}
@@ -0,0 +1,242 @@
+package v4
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// dataMarking is written in place of an observation that has been marked
+const dataMarking = "x"
+
+// ErrInvalidShape is returned when a synthetic V4 file cannot be generated
+var ErrInvalidShape = errors.New("invalid synthetic v4 shape")
+
+// unicodeLabels are cycled through when a synthetic file uses unicode labels
+var unicodeLabels = []string{
+	"Ünïcödé ☃",
+	"中文标签",
+	"עברית",
+	"Ελληνικά",
+	"emoji 📈📉",
+	"Combining é",
+}
+
+// Shape describes a synthetic V4 file. The file has a dimension for each entry
+// in Options, holding that many options. Rows cover every combination of
+// options, less roughly Sparsity of them chosen at random from Seed.
+type Shape struct {
+	CodeListPrefix string
+	Options        []int
+	Sparsity       float64
+	Seed           int64
+
+	// Dimensions gives the code list and name of the dimension for each entry
+	// in Options, so a file can be imported with a recipe that expects them.
+	// The column is ignored. Dimensions are named by position when empty.
+	Dimensions []DimensionHeader
+
+	// Markings is the number of data marking columns, and every MarkEvery-th
+	// observation is replaced by a data marking. Zero marks no observations.
+	Markings  int
+	MarkEvery int
+
+	// CodeLength pads codes to at least this many characters
+	CodeLength int
+
+	// UnicodeLabels uses multi-byte and right to left labels instead of ascii
+	UnicodeLabels bool
+}
+
+// Generate writes a synthetic V4 file in the given shape, returning the file
+// as Parse would read it. Rows are written as they are generated, so files of
+// millions of rows are never held in memory. Every option is used by at least
+// one row however sparse the file.
+func Generate(w io.Writer, shape Shape) (*File, error) {
+	if err := shape.validate(); err != nil {
+		return nil, err
+	}
+
+	header := shape.header()
+	parsed, err := ParseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{Header: parsed}
+	for d, h := range parsed.Dimensions {
+		dimension := &Dimension{DimensionHeader: h, seen: make(map[Option]bool)}
+		for o := 0; o < shape.Options[d]; o++ {
+			dimension.add(shape.option(d, o))
+		}
+		f.Dimensions = append(f.Dimensions, dimension)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	random := rand.New(rand.NewSource(shape.Seed))
+	used := make([]map[int]bool, len(shape.Options))
+	for d := range used {
+		used[d] = make(map[int]bool)
+	}
+
+	// indexes of the option of each dimension in the current row, with the
+	// last dimension changing fastest
+	indexes := make([]int, len(shape.Options))
+	row := make([]string, len(header))
+
+	for {
+		if random.Float64() >= shape.Sparsity || !allUsed(used, indexes) {
+			shape.row(row, f, indexes)
+			if err := writer.Write(row); err != nil {
+				return nil, err
+			}
+
+			for d, o := range indexes {
+				used[d][o] = true
+			}
+
+			f.Observations++
+			if row[0] == "" {
+				f.Marked++
+			}
+		}
+
+		if !next(indexes, shape.Options) {
+			break
+		}
+	}
+
+	writer.Flush()
+	return f, writer.Error()
+}
+
+// GenerateFile writes a synthetic V4 file in the given shape to the path
+func GenerateFile(filename string, shape Shape) (*File, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.ErrorC("GenerateFile", err, log.Data{"v4_file": filename})
+		}
+	}()
+
+	buffer := bufio.NewWriter(file)
+	f, err := Generate(buffer, shape)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, buffer.Flush()
+}
+
+func (s Shape) validate() error {
+	if len(s.Options) == 0 || s.Sparsity < 0 || s.Sparsity >= 1 || s.Markings < 0 || s.MarkEvery < 0 || s.CodeLength < 0 {
+		return ErrInvalidShape
+	}
+
+	if s.MarkEvery > 0 && s.Markings == 0 {
+		return ErrInvalidShape
+	}
+
+	if len(s.Dimensions) > 0 && len(s.Dimensions) != len(s.Options) {
+		return ErrInvalidShape
+	}
+
+	for _, o := range s.Options {
+		if o < 1 {
+			return ErrInvalidShape
+		}
+	}
+
+	return nil
+}
+
+func (s Shape) header() []string {
+	header := []string{headerPrefix + strconv.Itoa(s.Markings)}
+	for i := 0; i < s.Markings; i++ {
+		header = append(header, fmt.Sprintf("marking-%d", i+1))
+	}
+
+	for d := range s.Options {
+		if len(s.Dimensions) > 0 {
+			header = append(header, s.Dimensions[d].CodeListID, s.Dimensions[d].Name)
+			continue
+		}
+		header = append(header, fmt.Sprintf("%sdimension-%d", s.CodeListPrefix, d+1), fmt.Sprintf("dimension-%d", d+1))
+	}
+
+	return header
+}
+
+func (s Shape) option(d, o int) Option {
+	prefix := fmt.Sprintf("d%d-", d+1)
+	number := strconv.Itoa(o + 1)
+	if padding := s.CodeLength - len(prefix) - len(number); padding > 0 {
+		number = strings.Repeat("0", padding) + number
+	}
+
+	label := fmt.Sprintf("Dimension %d option %d", d+1, o+1)
+	if s.UnicodeLabels {
+		label = fmt.Sprintf("%s %d.%d", unicodeLabels[(d+o)%len(unicodeLabels)], d+1, o+1)
+	}
+
+	return Option{Code: prefix + number, Label: label}
+}
+
+// row fills the row with the observation and options for the given indexes
+func (s Shape) row(row []string, f *File, indexes []int) {
+	for i := 1; i <= s.Markings; i++ {
+		row[i] = ""
+	}
+
+	if s.MarkEvery > 0 && (f.Observations+1)%s.MarkEvery == 0 {
+		row[0] = ""
+		row[1] = dataMarking
+	} else {
+		row[0] = strconv.FormatFloat(float64(f.Observations%10000)/10, 'f', 1, 64)
+	}
+
+	for d, o := range indexes {
+		option := f.Dimensions[d].Options[o]
+		column := f.Dimensions[d].Column
+		row[column] = option.Code
+		row[column+1] = option.Label
+	}
+}
+
+// allUsed returns whether every option of the row has been used by an earlier row
+func allUsed(used []map[int]bool, indexes []int) bool {
+	for d, o := range indexes {
+		if !used[d][o] {
+			return false
+		}
+	}
+	return true
+}
+
+// next moves the indexes on to the next combination of options, returning
+// false once every combination has been visited
+func next(indexes, options []int) bool {
+	for d := len(indexes) - 1; d >= 0; d-- {
+		indexes[d]++
+		if indexes[d] < options[d] {
+			return true
+		}
+		indexes[d] = 0
+	}
+	return false
+}
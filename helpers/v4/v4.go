@@ -0,0 +1,260 @@
+// Package v4 parses and validates V4 files, the CSV format datasets are
+// imported from, so tests can derive their expectations from the file itself
+package v4
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ONSdigital/go-ns/log"
+)
+
+const headerPrefix = "V4_"
+
+// ErrInvalidHeader is returned when the first row of a file is not a valid V4 header
+var ErrInvalidHeader = errors.New("invalid v4 header")
+
+// RowError is returned when a row of a V4 file is invalid. Rows are numbered
+// from 1, with the header as row 1, to match line numbers in the file
+type RowError struct {
+	Row    int
+	Reason string
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("invalid v4 row %d: %s", e.Row, e.Reason)
+}
+
+// Header describes the columns of a V4 file. The observation is followed by
+// Markings data marking columns, then a code and label column per dimension
+type Header struct {
+	Row        []string
+	Markings   int
+	Dimensions []DimensionHeader
+}
+
+// DimensionHeader describes the pair of columns holding a dimension
+type DimensionHeader struct {
+	CodeListID string
+	Name       string
+	Column     int
+}
+
+// Option is a code and label pair of a dimension
+type Option struct {
+	Code  string
+	Label string
+}
+
+// Dimension holds the options used by a dimension, in the order they first
+// appear in the file
+type Dimension struct {
+	DimensionHeader
+	Options []Option
+
+	seen map[Option]bool
+}
+
+// File is a parsed V4 file. Marked is the number of observations replaced by
+// a data marking, which are included in Observations
+type File struct {
+	Header       *Header
+	Dimensions   []*Dimension
+	Observations int
+	Marked       int
+}
+
+// ParseHeader parses and validates the header row of a V4 file
+func ParseHeader(row []string) (*Header, error) {
+	if len(row) == 0 || !strings.HasPrefix(row[0], headerPrefix) {
+		return nil, ErrInvalidHeader
+	}
+
+	markings, err := strconv.Atoi(strings.TrimPrefix(row[0], headerPrefix))
+	if err != nil || markings < 0 {
+		return nil, ErrInvalidHeader
+	}
+
+	columns := len(row) - 1 - markings
+	if columns < 2 || columns%2 != 0 {
+		return nil, ErrInvalidHeader
+	}
+
+	header := &Header{Row: row, Markings: markings}
+	names := make(map[string]bool)
+
+	for i := markings + 1; i < len(row); i += 2 {
+		d := DimensionHeader{CodeListID: row[i], Name: row[i+1], Column: i}
+		if d.CodeListID == "" || d.Name == "" || names[d.Name] {
+			return nil, ErrInvalidHeader
+		}
+
+		names[d.Name] = true
+		header.Dimensions = append(header.Dimensions, d)
+	}
+
+	return header, nil
+}
+
+// Parse reads a whole V4 file, validating each row against the header. A row
+// is invalid if it has the wrong number of columns, an empty code or label, no
+// observation or data marking, or repeats the options of an earlier row.
+func Parse(r io.Reader) (*File, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	headerRow, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := ParseHeader(headerRow)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{Header: header}
+	for _, h := range header.Dimensions {
+		f.Dimensions = append(f.Dimensions, &Dimension{DimensionHeader: h, seen: make(map[Option]bool)})
+	}
+
+	// rows keyed by the options of the row, to find repeated observations
+	rows := make(map[string]int)
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(row) != len(headerRow) {
+			return nil, &RowError{Row: line, Reason: fmt.Sprintf("has %d columns, expected %d", len(row), len(headerRow))}
+		}
+
+		if !hasObservation(row, header.Markings) {
+			return nil, &RowError{Row: line, Reason: "has no observation or data marking"}
+		}
+
+		var key []string
+		for _, d := range f.Dimensions {
+			option := Option{Code: row[d.Column], Label: row[d.Column+1]}
+			if option.Code == "" || option.Label == "" {
+				return nil, &RowError{Row: line, Reason: "has an empty code or label for dimension " + d.Name}
+			}
+
+			d.add(option)
+			key = append(key, option.Code, option.Label)
+		}
+
+		k := strings.Join(key, "\x00")
+		if first, ok := rows[k]; ok {
+			return nil, &RowError{Row: line, Reason: fmt.Sprintf("repeats the options of row %d", first)}
+		}
+		rows[k] = line
+
+		f.Observations++
+		if row[0] == "" {
+			f.Marked++
+		}
+	}
+
+	return f, nil
+}
+
+// ParseFile parses the V4 file at the given path
+func ParseFile(filename string) (*File, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.ErrorC("ParseFile", err, log.Data{"v4_file": filename})
+		}
+	}()
+
+	return Parse(file)
+}
+
+// Dimension returns the dimension with the given name, or nil if the file has
+// no such dimension
+func (f *File) Dimension(name string) *Dimension {
+	for _, d := range f.Dimensions {
+		if d.Name == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// Options returns the number of options across all dimensions of the file
+func (f *File) Options() int {
+	var total int
+	for _, d := range f.Dimensions {
+		total += len(d.Options)
+	}
+	return total
+}
+
+// Combinations returns the number of observations the file would hold if it
+// had one for every combination of dimension options
+func (f *File) Combinations() int {
+	if len(f.Dimensions) == 0 {
+		return 0
+	}
+
+	total := 1
+	for _, d := range f.Dimensions {
+		total *= len(d.Options)
+	}
+	return total
+}
+
+// Sparsity returns the fraction of combinations of dimension options the file
+// has no observation for, from 0 for a complete file up to but excluding 1
+func (f *File) Sparsity() float64 {
+	combinations := f.Combinations()
+	if combinations == 0 {
+		return 0
+	}
+	return 1 - float64(f.Observations)/float64(combinations)
+}
+
+// Codes returns the distinct codes used by the dimension
+func (d *Dimension) Codes() []string {
+	var codes []string
+	seen := make(map[string]bool)
+	for _, o := range d.Options {
+		if !seen[o.Code] {
+			seen[o.Code] = true
+			codes = append(codes, o.Code)
+		}
+	}
+	return codes
+}
+
+func (d *Dimension) add(option Option) {
+	if !d.seen[option] {
+		d.seen[option] = true
+		d.Options = append(d.Options, option)
+	}
+}
+
+// hasObservation returns whether a row has an observation or, where the value
+// is missing, a data marking explaining why
+func hasObservation(row []string, markings int) bool {
+	for i := 0; i <= markings; i++ {
+		if row[i] != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package identityAPI
+
+import (
+	"fmt"
+
+	"github.com/ONSdigital/dp-api-tests/identityAPIModels"
+	uuid "github.com/satori/go.uuid"
+)
+
+// defaultIdentity is the identity the identity API returns for any token
+var defaultIdentity = identityAPIModels.API{
+	Name:              "John Paul Jones",
+	Email:             "blackdog@ons.gov.uk",
+	Password:          "foo",
+	UserType:          "bar",
+	TemporaryPassword: false,
+	Migrated:          false,
+	Deleted:           false,
+}
+
+// newIdentity returns a valid identity with an email unique to the test, so
+// identities created by one test are never found by another
+func newIdentity() identityAPIModels.API {
+	return identityAPIModels.API{
+		Name:              "Peter Venkman",
+		Email:             fmt.Sprintf("venkman+%s@whoyougunnacall.com", uuid.NewV4().String()),
+		Deleted:           false,
+		Migrated:          true,
+		Password:          "There is no Dana only zuul!",
+		TemporaryPassword: false,
+		UserType:          "admin",
+	}
+}
+
+// newTokenRequest returns a request for a token for the identity with the given password
+func newTokenRequest(identity identityAPIModels.API, password string) identityAPIModels.NewTokenRequest {
+	return identityAPIModels.NewTokenRequest{
+		Email:    identity.Email,
+		Password: password,
+	}
+}
@@ -0,0 +1,75 @@
+package identityAPI
+
+import (
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/ONSdigital/dp-api-tests/config"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+var cfg *config.Config
+
+const (
+	collection = "identities"
+
+	// identityAPIURLEnv opts in to the identity API tests, which are skipped
+	// unless it is set and the identity API can be reached
+	identityAPIURLEnv = "IDENTITY_API_URL"
+)
+
+// identityAPIUnavailable is the reason the identity API tests are skipped, or
+// empty if they are to be run
+var identityAPIUnavailable string
+
+func init() {
+	var err error
+	cfg, err = config.Get()
+	if err != nil {
+		log.ErrorC("Unable to access configurations", err, nil)
+		os.Exit(1)
+	}
+
+	if identityAPIUnavailable = checkIdentityAPI(); identityAPIUnavailable != "" {
+		log.Info("skipping identity api tests", log.Data{"reason": identityAPIUnavailable})
+		return
+	}
+
+	if err = mongo.NewDatastore(cfg.MongoAddr); err != nil {
+		log.ErrorC("mongodb datastore error", err, nil)
+		os.Exit(1)
+	}
+
+	test := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: collection,
+		Key:        "test_data",
+		Value:      "true",
+	}
+
+	if err = mongo.Teardown(test); err != nil {
+		log.ErrorC("Unable to remove all test data from mongo db", err, nil)
+		os.Exit(1)
+	}
+
+	log.Debug("config is:", log.Data{"config": cfg})
+}
+
+// checkIdentityAPI returns why the identity API cannot be tested, or an empty
+// string if the identity API has been opted in to and responds to requests
+func checkIdentityAPI() string {
+	if os.Getenv(identityAPIURLEnv) == "" {
+		return identityAPIURLEnv + " is not set"
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get(cfg.IdentityAPIURL + "/healthcheck")
+	if err != nil {
+		return "identity api is not reachable: " + err.Error()
+	}
+	resp.Body.Close()
+
+	return ""
+}
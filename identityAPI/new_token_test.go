@@ -0,0 +1,82 @@
+package identityAPI
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewToken_Success(t *testing.T) {
+	skipUnlessIdentityAPI(t)
+	identityAPI := httpexpect.New(t, cfg.IdentityAPIURL)
+
+	Convey("Given an identity exists", t, func() {
+		identity := newIdentity()
+		identityID := createIdentity(identityAPI, identity)
+
+		Convey("When a newTokenRequest made with the correct password", func() {
+			resp := identityAPI.POST("/token").
+				WithJSON(newTokenRequest(identity, identity.Password)).
+				Expect()
+
+			Convey("Then a 200 status and auth token are returned", func() {
+				resp.Status(http.StatusOK)
+
+				token := resp.JSON().Object().Value("token").String().Raw()
+				So(token, ShouldNotBeEmpty)
+			})
+		})
+
+		Reset(func() {
+			tearDown(identityID)
+		})
+	})
+}
+
+func TestNewToken_PasswordIncorrect(t *testing.T) {
+	skipUnlessIdentityAPI(t)
+	identityAPI := httpexpect.New(t, cfg.IdentityAPIURL)
+
+	Convey("Given an identity exists", t, func() {
+		identity := newIdentity()
+		identityID := createIdentity(identityAPI, identity)
+
+		Convey("When a newTokenRequest is made with an incorrect password", func() {
+			resp := identityAPI.POST("/token").
+				WithJSON(newTokenRequest(identity, "this password is incorrect")).
+				Expect()
+
+			Convey("Then a 403 status is returned", func() {
+				resp.Status(http.StatusForbidden)
+				So(strings.TrimSpace(resp.Body().Raw()), ShouldEqual, "authentication unsuccessful")
+			})
+		})
+
+		Reset(func() {
+			tearDown(identityID)
+		})
+	})
+}
+
+func TestNewToken_UserNotFound(t *testing.T) {
+	skipUnlessIdentityAPI(t)
+	identityAPI := httpexpect.New(t, cfg.IdentityAPIURL)
+
+	Convey("Given no user exists with the provided identity ID", t, func() {
+		identity := newIdentity()
+
+		Convey("When a newTokenRest is made", func() {
+			resp := identityAPI.POST("/token").
+				WithJSON(newTokenRequest(identity, identity.Password)).
+				Expect()
+
+			Convey("Then a 404 status is returned", func() {
+				resp.Status(http.StatusNotFound)
+				So(strings.TrimSpace(resp.Body().Raw()), ShouldEqual, "authentication unsuccessful user not found")
+			})
+		})
+	})
+}
@@ -0,0 +1,184 @@
+package identityAPI
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-tests/identityAPIModels"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/dp-identity-api/schema"
+	"github.com/ONSdigital/go-ns/log"
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCreateIdentitySuccess(t *testing.T) {
+	skipUnlessIdentityAPI(t)
+	identityAPI := httpexpect.New(t, cfg.IdentityAPIURL)
+
+	Convey("Given a valid identity", t, func() {
+		identity := newIdentity()
+
+		Convey("When post request made to the API", func() {
+			Convey("Then the identity is stored", func() {
+
+				resp := identityAPI.POST("/identity").
+					WithJSON(identity).
+					Expect().
+					Status(http.StatusCreated)
+
+				newID := resp.JSON().Object().Value("id").String().Raw()
+				i, err := mongo.GetIdentity(cfg.MongoDB, collection, "id", newID)
+				So(err, ShouldBeNil)
+
+				So(i.ID, ShouldEqual, newID)
+				So(i.Name, ShouldEqual, identity.Name)
+				So(i.Email, ShouldEqual, identity.Email)
+
+				Convey("and the password is encrypted", func() {
+					pwdErr := bcrypt.CompareHashAndPassword(i.HashedPassword(), []byte(identity.Password))
+					So(pwdErr, ShouldBeNil)
+				})
+
+				tearDown(i.ID)
+			})
+		})
+	})
+}
+
+func TestCreateIdentity_EmailAlreadyAssociated(t *testing.T) {
+	skipUnlessIdentityAPI(t)
+	identityAPI := httpexpect.New(t, cfg.IdentityAPIURL)
+
+	Convey("Given an email is already associated with an identity", t, func() {
+		identity := newIdentity()
+		id := createIdentity(identityAPI, identity)
+
+		Convey("When a post request made to the API with the same email", func() {
+			resp := identityAPI.POST("/identity").
+				WithJSON(identity).
+				Expect()
+
+			Convey("Then a 409 status is returned", func() {
+				resp.Status(http.StatusConflict)
+				So(getErrorBody(resp), ShouldEqual, "active identity already exists with email")
+			})
+		})
+
+		Reset(func() {
+			tearDown(id)
+		})
+	})
+}
+
+func TestCreateIdentity_ValidationError(t *testing.T) {
+	skipUnlessIdentityAPI(t)
+	identityAPI := httpexpect.New(t, cfg.IdentityAPIURL)
+
+	Convey("Given the request body is empty", t, func() {
+		Convey("When post request is made to the API", func() {
+			Convey("Then a Bad Request status is returned and no identity is stored", func() {
+				resp := identityAPI.POST("/identity").
+					WithHeader("Content-Type", "application/json").
+					Expect().
+					Status(http.StatusBadRequest)
+
+				So(getErrorBody(resp), ShouldEqual, "error expected request body but was empty")
+				So(countIdentities(""), ShouldEqual, 0)
+			})
+		})
+	})
+
+	Convey("Given the identity.name is empty", t, func() {
+		Convey("When post request is made to the API", func() {
+			Convey("Then a Bad Request status is returned and no identity is stored", func() {
+				identity := newIdentity()
+				identity.Name = ""
+
+				resp := identityAPI.POST("/identity").
+					WithJSON(identity).
+					Expect().
+					Status(http.StatusBadRequest)
+
+				So(getErrorBody(resp), ShouldEqual, schema.ErrNameValidation.Error())
+				So(countIdentities(identity.Email), ShouldEqual, 0)
+			})
+		})
+	})
+
+	Convey("Given the identity.email is empty", t, func() {
+		Convey("When post request is made to the API", func() {
+			Convey("Then a Bad Request status is returned and no identity is stored", func() {
+				identity := identityAPIModels.API{Name: "Edmund Blackadder"}
+
+				resp := identityAPI.POST("/identity").
+					WithJSON(identity).
+					Expect().
+					Status(http.StatusBadRequest)
+
+				So(getErrorBody(resp), ShouldEqual, schema.ErrEmailValidation.Error())
+				So(countIdentities(""), ShouldEqual, 0)
+			})
+		})
+	})
+
+	Convey("Given the identity.password is empty", t, func() {
+		Convey("When post request is made to the API", func() {
+			Convey("Then a Bad Request status is returned and no identity is stored", func() {
+				identity := newIdentity()
+				identity.Password = ""
+
+				resp := identityAPI.POST("/identity").
+					WithJSON(identity).
+					Expect().
+					Status(http.StatusBadRequest)
+
+				So(getErrorBody(resp), ShouldEqual, schema.ErrPasswordValidation.Error())
+				So(countIdentities(identity.Email), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+// skipUnlessIdentityAPI skips a test unless the identity API has been opted in
+// to with IDENTITY_API_URL and can be reached
+func skipUnlessIdentityAPI(t *testing.T) {
+	if identityAPIUnavailable != "" {
+		t.Skip(identityAPIUnavailable)
+	}
+}
+
+func createIdentity(identityAPI *httpexpect.Expect, identity identityAPIModels.API) string {
+	resp := identityAPI.POST("/identity").
+		WithJSON(identity).
+		Expect().
+		Status(http.StatusCreated)
+
+	return resp.JSON().Object().Value("id").String().Raw()
+}
+
+func countIdentities(email string) int {
+	count, err := mongo.CountIdentities(cfg.MongoDB, collection, "email", email)
+	So(err, ShouldBeNil)
+	return count
+}
+
+func getErrorBody(resp *httpexpect.Response) string {
+	return strings.TrimSpace(resp.Body().Raw())
+}
+
+func tearDown(id string) {
+	doc := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: collection,
+		Key:        "id",
+		Value:      id,
+	}
+
+	err := mongo.Teardown(doc)
+	if err != nil {
+		log.ErrorC("failed to tear down identities docs", err, nil)
+	}
+}
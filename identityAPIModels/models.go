@@ -0,0 +1,36 @@
+package identityAPIModels
+
+// API represents an identity as sent to and returned from the identity API
+type API struct {
+	ID                string `bson:"id" json:"id"`
+	Name              string `bson:"name" json:"name"`
+	Email             string `bson:"email" json:"email"`
+	Password          string `bson:"password" json:"password"`
+	UserType          string `bson:"user_type" json:"user_type"`
+	TemporaryPassword bool   `bson:"temporary_password" json:"temporary_password"`
+	Migrated          bool   `bson:"migrated" json:"migrated"`
+	Deleted           bool   `bson:"deleted" json:"deleted"`
+}
+
+// Mongo represents an identity as stored in mongo, where the password is hashed
+type Mongo struct {
+	ID                string `bson:"id" json:"id"`
+	Name              string `bson:"name" json:"name"`
+	Email             string `bson:"email" json:"email"`
+	Password          string `bson:"password" json:"password"`
+	UserType          string `bson:"user_type" json:"user_type"`
+	TemporaryPassword bool   `bson:"temporary_password" json:"temporary_password"`
+	Migrated          bool   `bson:"migrated" json:"migrated"`
+	Deleted           bool   `bson:"deleted" json:"deleted"`
+}
+
+// NewTokenRequest represents the body of a request for a new auth token
+type NewTokenRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// HashedPassword returns the stored bcrypt hash of the identity password
+func (m Mongo) HashedPassword() []byte {
+	return []byte(m.Password)
+}
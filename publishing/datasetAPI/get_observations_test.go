@@ -17,7 +17,6 @@ import (
 )
 
 const (
-	observationTestData          = "../../testDataSetup/neo4j/instance.cypher"
 	expectedNumberOfObservations = 137
 )
 
@@ -32,24 +31,14 @@ func TestSuccessfullyGetObservationsForVersion(t *testing.T) {
 
 	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
 
-	publishedGraphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, ids.InstancePublished, observationTestData)
+	publishedGraphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, ids.InstancePublished, neo4j.BuildCPIInstance)
 	if err != nil {
-		log.ErrorC("Unable to connect to a neo4j instance", err, nil)
-		os.Exit(1)
-	}
-
-	if err = publishedGraphData.Setup(); err != nil {
 		log.ErrorC("Unable to setup graph data", err, nil)
 		os.Exit(1)
 	}
 
-	unpublishedGraphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, ids.InstanceAssociated, observationTestData)
+	unpublishedGraphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, ids.InstanceAssociated, neo4j.BuildCPIInstance)
 	if err != nil {
-		log.ErrorC("Unable to connect to a neo4j instance", err, nil)
-		os.Exit(1)
-	}
-
-	if err := unpublishedGraphData.Setup(); err != nil {
 		log.ErrorC("Unable to setup graph data", err, nil)
 		os.Exit(1)
 	}
@@ -196,24 +185,14 @@ func TestFailureToGetObservationsForVersion(t *testing.T) {
 
 	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
 
-	publishedGraphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, ids.InstancePublished, observationTestData)
+	publishedGraphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, ids.InstancePublished, neo4j.BuildCPIInstance)
 	if err != nil {
-		log.ErrorC("Unable to connect to a neo4j instance", err, nil)
-		os.Exit(1)
-	}
-
-	if err = publishedGraphData.Setup(); err != nil {
 		log.ErrorC("Unable to setup graph data", err, nil)
 		os.Exit(1)
 	}
 
-	unpublishedGraphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, ids.InstanceAssociated, observationTestData)
+	unpublishedGraphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, ids.InstanceAssociated, neo4j.BuildCPIInstance)
 	if err != nil {
-		log.ErrorC("Unable to connect to a neo4j instance", err, nil)
-		os.Exit(1)
-	}
-
-	if err := unpublishedGraphData.Setup(); err != nil {
 		log.ErrorC("Unable to setup graph data", err, nil)
 		os.Exit(1)
 	}
@@ -0,0 +1,13 @@
+package datasetAPI
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+)
+
+// TestMain fails the suite if any neo4j test data is left behind once it has run
+func TestMain(m *testing.M) {
+	os.Exit(neo4j.CheckResidue(cfg.Neo4jAddr, m.Run()))
+}
@@ -0,0 +1,188 @@
+package filterAPI
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/blueprint"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/log"
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	// blueprintScenarios is the number of sequences of operations run, each
+	// generated from the seed after the last
+	blueprintScenarios = 5
+
+	// blueprintScenarioSteps is the number of operations in each sequence
+	blueprintScenarioSteps = 15
+)
+
+func TestGeneratedFilterBlueprintScenarios(t *testing.T) {
+	for i := int64(0); i < blueprintScenarios; i++ {
+		runBlueprintScenario(t, cfg.FilterScenarioSeed+i)
+	}
+}
+
+// runBlueprintScenario makes a generated sequence of operations against a
+// filter blueprint of a seeded instance, checking the blueprint against a
+// model of it after every operation, and the filter output created on submit
+func runBlueprintScenario(t *testing.T, seed int64) {
+	filterBlueprintID, instanceID, docs, initial := setupFilterBlueprint()
+
+	filterAPI := httpexpect.New(t, cfg.FilterAPIURL)
+
+	generator := &blueprint.Generator{Options: seededDimensionOptions(), Steps: blueprintScenarioSteps, Seed: seed}
+	operations, err := generator.Generate(initial)
+	if err != nil {
+		log.ErrorC("Unable to generate filter blueprint scenario", err, log.Data{"seed": seed})
+		t.FailNow()
+	}
+
+	Convey("Given a filter blueprint of a seeded instance", t, func() {
+
+		if err := mongo.Setup(docs...); err != nil {
+			log.ErrorC("Unable to setup filter blueprint scenario test resources", err, nil)
+			os.Exit(1)
+		}
+
+		Convey("When the operations generated from seed "+strconv.FormatInt(seed, 10)+" are made against it", func() {
+
+			model := blueprint.NewModel(initial)
+			var filterOutputID string
+
+			for i, operation := range operations {
+				log.Info("filter blueprint scenario operation", log.Data{"seed": seed, "step": i + 1, "operation": operation.String()})
+
+				outputID := makeBlueprintOperation(filterAPI, filterBlueprintID, operation)
+				model.Apply(operation)
+
+				checkBlueprintMatchesModel(filterAPI, filterBlueprintID, model, seed, i+1)
+
+				if outputID != "" {
+					if operation.Kind == blueprint.Resubmit {
+						So(outputID, ShouldNotEqual, filterOutputID)
+					}
+					filterOutputID = outputID
+
+					docs = append(docs, &mongo.Doc{
+						Database:   cfg.MongoFiltersDB,
+						Collection: "filterOutputs",
+						Key:        "filter_id",
+						Value:      filterOutputID,
+					})
+
+					checkFilterOutputMatchesModel(filterOutputID, filterBlueprintID, instanceID, model)
+				}
+			}
+		})
+
+		if err := mongo.Teardown(docs...); err != nil {
+			log.ErrorC("Unable to remove filter blueprint scenario test resources from mongo db", err, nil)
+			os.Exit(1)
+		}
+	})
+}
+
+// makeBlueprintOperation makes the request for an operation, checking the
+// filter API accepts it, and returns the id of the filter output created if
+// the operation submits the blueprint
+func makeBlueprintOperation(filterAPI *httpexpect.Expect, filterBlueprintID string, operation blueprint.Operation) string {
+	switch operation.Kind {
+	case blueprint.AddDimension:
+		response := filterAPI.POST("/filters/{filter_blueprint_id}/dimensions/{dimension}", filterBlueprintID, operation.Dimension).
+			WithHeader(serviceAuthTokenName, serviceAuthToken).
+			WithBytes([]byte(GetValidPOSTDimensionToFilterBlueprintJSON(operation.Options...))).
+			Expect().Status(http.StatusCreated).JSON().Object()
+		validateDimensionResponse(*response, filterBlueprintID, operation.Dimension)
+
+	case blueprint.RemoveDimension:
+		filterAPI.DELETE("/filters/{filter_blueprint_id}/dimensions/{dimension}", filterBlueprintID, operation.Dimension).
+			WithHeader(serviceAuthTokenName, serviceAuthToken).
+			Expect().Status(http.StatusNoContent)
+
+	case blueprint.AddOption:
+		response := filterAPI.POST("/filters/{filter_blueprint_id}/dimensions/{dimension}/options/{option}", filterBlueprintID, operation.Dimension, operation.Options[0]).
+			WithHeader(serviceAuthTokenName, serviceAuthToken).
+			Expect().Status(http.StatusCreated).JSON().Object()
+		validateOptionResponse(*response, filterBlueprintID, operation.Dimension, operation.Options[0])
+
+	case blueprint.RemoveOption:
+		filterAPI.DELETE("/filters/{filter_blueprint_id}/dimensions/{dimension}/options/{option}", filterBlueprintID, operation.Dimension, operation.Options[0]).
+			WithHeader(serviceAuthTokenName, serviceAuthToken).
+			Expect().Status(http.StatusNoContent)
+
+	case blueprint.ReplaceOptions:
+		filterAPI.PUT("/filters/{filter_blueprint_id}", filterBlueprintID).
+			WithHeader(serviceAuthTokenName, serviceAuthToken).
+			WithJSON(map[string]interface{}{"dimensions": operation.Dimensions}).
+			Expect().Status(http.StatusOK)
+
+	case blueprint.Submit, blueprint.Resubmit:
+		response := filterAPI.PUT("/filters/{filter_blueprint_id}", filterBlueprintID).
+			WithHeader(serviceAuthTokenName, serviceAuthToken).
+			WithQuery("submitted", "true").
+			WithBytes([]byte(`{}`)).
+			Expect().Status(http.StatusOK).JSON().Object()
+
+		filterOutputLinkObject := response.Value("links").Object().Value("filter_output").Object()
+		filterOutputLinkObject.Value("href").String().Match("(.+)/filter-outputs/(.+)$")
+		return filterOutputLinkObject.Value("id").String().Raw()
+	}
+
+	return ""
+}
+
+// checkBlueprintMatchesModel reads the dimensions and options of the blueprint
+// from the filter API and compares them with the model
+func checkBlueprintMatchesModel(filterAPI *httpexpect.Expect, filterBlueprintID string, model *blueprint.Model, seed int64, step int) {
+	var actual []blueprint.Dimension
+
+	dimensions := filterAPI.GET("/filters/{filter_blueprint_id}/dimensions", filterBlueprintID).
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		Expect().Status(http.StatusOK).JSON().Array()
+
+	for _, d := range dimensions.Iter() {
+		name := d.Object().Value("name").String().Raw()
+
+		options := filterAPI.GET("/filters/{filter_blueprint_id}/dimensions/{dimension}/options", filterBlueprintID, name).
+			WithHeader(serviceAuthTokenName, serviceAuthToken).
+			Expect().Status(http.StatusOK).JSON().Array()
+
+		dimension := blueprint.Dimension{Name: name}
+		for _, o := range options.Iter() {
+			dimension.Options = append(dimension.Options, o.Object().Value("option").String().Raw())
+		}
+		actual = append(actual, dimension)
+	}
+
+	differences := model.Compare(actual)
+	for _, difference := range differences {
+		log.Info("filter blueprint does not match the model", log.Data{"seed": seed, "step": step, "difference": difference.String()})
+	}
+	So(differences, ShouldBeEmpty)
+}
+
+// checkFilterOutputMatchesModel checks a filter output created on submit links
+// back to the blueprint and holds the dimensions of the model
+func checkFilterOutputMatchesModel(filterOutputID, filterBlueprintID, instanceID string, model *blueprint.Model) {
+	filterOutput, err := mongo.GetFilter(cfg.MongoFiltersDB, "filterOutputs", "filter_id", filterOutputID)
+	if err != nil {
+		log.ErrorC("Unable to retrieve filter output document", err, log.Data{"filter_output_id": filterOutputID})
+	}
+
+	So(err, ShouldBeNil)
+	So(filterOutput.FilterID, ShouldEqual, filterOutputID)
+	So(filterOutput.InstanceID, ShouldEqual, instanceID)
+	So(filterOutput.Links.FilterBlueprint.ID, ShouldEqual, filterBlueprintID)
+
+	var actual []blueprint.Dimension
+	for _, d := range filterOutput.Dimensions {
+		actual = append(actual, blueprint.Dimension{Name: d.Name, Options: d.Options})
+	}
+	So(model.Compare(actual), ShouldBeEmpty)
+}
@@ -0,0 +1,115 @@
+package filterAPI
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/blueprint"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/log"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const (
+	// concurrentRepeats is the number of identical requests sent for each
+	// option added or removed, so repeated options are caught
+	concurrentRepeats = 3
+
+	// concurrentSubmits is the number of submits sent alongside the changes
+	concurrentSubmits = 5
+)
+
+func TestConcurrentFilterBlueprintModifications(t *testing.T) {
+
+	filterBlueprintID, _, docs, initial := setupFilterBlueprint()
+
+	harness := &blueprint.Harness{
+		FilterAPIURL: cfg.FilterAPIURL,
+		Headers:      map[string]string{serviceAuthTokenName: serviceAuthToken},
+	}
+
+	Convey("Given an existing filter blueprint", t, func() {
+
+		if err := mongo.Setup(docs...); err != nil {
+			log.ErrorC("Unable to setup concurrent filter blueprint test resources", err, nil)
+			os.Exit(1)
+		}
+
+		Convey("When options are added and removed and the blueprint submitted all at the same time", func() {
+
+			operations := blueprint.ConcurrentOperations(initial, seededDimensionOptions(), concurrentRepeats, concurrentSubmits)
+			outcomes := harness.Run(context.Background(), filterBlueprintID, operations)
+
+			for _, o := range outcomes {
+				if o.FilterOutputID != "" {
+					docs = append(docs, &mongo.Doc{
+						Database:   cfg.MongoFiltersDB,
+						Collection: "filterOutputs",
+						Key:        "filter_id",
+						Value:      o.FilterOutputID,
+					})
+				}
+			}
+
+			log.Info("concurrent filter blueprint requests sent", log.Data{"requests": len(outcomes), "statuses": blueprint.StatusCounts(outcomes)})
+			for _, o := range blueprint.Failed(outcomes) {
+				log.Info("concurrent filter blueprint request failed", log.Data{
+					"operation": o.Operation.String(),
+					"status":    o.Status,
+					"body":      o.Body,
+					"error":     o.Err,
+				})
+			}
+
+			// the checks share one Then, as goconvey runs the When, sending every
+			// request again, for each Then
+			Convey("Then the blueprint holds the changes of every request that succeeded once, and each submit created its own output", func() {
+
+				for _, o := range outcomes {
+					So(o.Err, ShouldBeNil)
+				}
+
+				filterBlueprint, err := mongo.GetFilter(cfg.MongoFiltersDB, collection, "filter_id", filterBlueprintID)
+				if err != nil {
+					log.ErrorC("Unable to retrieve updated document", err, nil)
+					t.FailNow()
+				}
+
+				var final []blueprint.Dimension
+				for _, d := range filterBlueprint.Dimensions {
+					final = append(final, blueprint.Dimension{Name: d.Name, Options: d.Options})
+				}
+
+				differences := blueprint.CheckConcurrent(initial, outcomes, final)
+				for _, difference := range differences {
+					log.Info("filter blueprint lost or corrupted a concurrent update", log.Data{"difference": difference.String()})
+				}
+				So(differences, ShouldBeEmpty)
+
+				created := make(map[string]bool)
+				for _, o := range outcomes {
+					if o.Operation.Kind != blueprint.Submit || !o.Succeeded() {
+						continue
+					}
+
+					So(o.FilterOutputID, ShouldNotBeEmpty)
+					So(created[o.FilterOutputID], ShouldBeFalse)
+					created[o.FilterOutputID] = true
+
+					filterOutput, err := mongo.GetFilter(cfg.MongoFiltersDB, "filterOutputs", "filter_id", o.FilterOutputID)
+					if err != nil {
+						log.ErrorC("Unable to retrieve filter output document", err, log.Data{"filter_output_id": o.FilterOutputID})
+						t.FailNow()
+					}
+					So(filterOutput.Links.FilterBlueprint.ID, ShouldEqual, filterBlueprintID)
+				}
+			})
+		})
+
+		if err := mongo.Teardown(docs...); err != nil {
+			log.ErrorC("Unable to remove concurrent filter blueprint test resources from mongo db", err, nil)
+			os.Exit(1)
+		}
+	})
+}
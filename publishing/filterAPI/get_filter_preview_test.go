@@ -37,13 +37,8 @@ func TestSuccessfullyGetFilterOutputPreview(t *testing.T) {
 			log.ErrorC("Unable to setup test data", err, nil)
 			os.Exit(1)
 		}
-		graphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, neo4j.ObservationTestData)
+		graphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 		if err != nil {
-			log.ErrorC("Unable to connect to s neo4j instance", err, nil)
-			os.Exit(1)
-		}
-
-		if err := graphData.Setup(); err != nil {
 			log.ErrorC("Unable to setup graph data", err, nil)
 			os.Exit(1)
 		}
@@ -88,13 +83,8 @@ func TestErrorCasesGetFilterOutputPreview(t *testing.T) {
 			log.ErrorC("Unable to setup test data", err, nil)
 			os.Exit(1)
 		}
-		graphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, neo4j.ObservationTestData)
+		graphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 		if err != nil {
-			log.ErrorC("Unable to connect to s neo4j instance", err, nil)
-			os.Exit(1)
-		}
-
-		if err := graphData.Setup(); err != nil {
 			log.ErrorC("Unable to setup graph data", err, nil)
 			os.Exit(1)
 		}
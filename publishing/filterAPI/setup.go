@@ -4,6 +4,7 @@ import (
 	"github.com/globalsign/mgo/bson"
 	"github.com/satori/go.uuid"
 
+	"github.com/ONSdigital/dp-api-tests/helpers/blueprint"
 	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
 )
 
@@ -17,35 +18,77 @@ func setupDimensionOptions(id string, update bson.M) *mongo.Doc {
 	}
 }
 
+// seededOptions are the options of each dimension setupMultipleDimensionsAndOptions
+// gives an instance
+var seededOptions = []struct {
+	dimension string
+	data      func(instanceID, option string) bson.M
+	options   []string
+}{
+	{"age", GetValidAgeDimensionData, []string{"27", "28"}},
+	{"sex", GetValidSexDimensionData, []string{"male", "female", "unknown"}},
+	{"Goods and services", GetValidGoodsAndServicesDimensionData, []string{"Education", "health", "communication", "welfare"}},
+	{"aggregate", GetValidAggregateDimensionData, []string{"cpi1dim1T60000", "cpi1dim1S10201", "cpi1dim1S10105"}},
+	{"time", GetValidTimeDimensionData, []string{"March 1997", "April 1997", "June 1997", "September 1997", "December 1997", "February 2007"}},
+	{"Residence Type", GetValidResidenceTypeDimensionData, []string{"Lives in a communal establishment", "Lives in a household"}},
+}
+
 func setupMultipleDimensionsAndOptions(instanceID string) []*mongo.Doc {
 	var docs []*mongo.Doc
 
-	options := []bson.M{
-		GetValidAgeDimensionData(instanceID, "27"),
-		GetValidAgeDimensionData(instanceID, "28"),
-		GetValidSexDimensionData(instanceID, "male"),
-		GetValidSexDimensionData(instanceID, "female"),
-		GetValidSexDimensionData(instanceID, "unknown"),
-		GetValidGoodsAndServicesDimensionData(instanceID, "Education"),
-		GetValidGoodsAndServicesDimensionData(instanceID, "health"),
-		GetValidGoodsAndServicesDimensionData(instanceID, "communication"),
-		GetValidGoodsAndServicesDimensionData(instanceID, "welfare"),
-		GetValidAggregateDimensionData(instanceID, "cpi1dim1T60000"),
-		GetValidAggregateDimensionData(instanceID, "cpi1dim1S10201"),
-		GetValidAggregateDimensionData(instanceID, "cpi1dim1S10105"),
-		GetValidTimeDimensionData(instanceID, "March 1997"),
-		GetValidTimeDimensionData(instanceID, "April 1997"),
-		GetValidTimeDimensionData(instanceID, "June 1997"),
-		GetValidTimeDimensionData(instanceID, "September 1997"),
-		GetValidTimeDimensionData(instanceID, "December 1997"),
-		GetValidTimeDimensionData(instanceID, "February 2007"),
-		GetValidResidenceTypeDimensionData(instanceID, "Lives in a communal establishment"),
-		GetValidResidenceTypeDimensionData(instanceID, "Lives in a household"),
+	for _, s := range seededOptions {
+		for _, option := range s.options {
+			docs = append(docs, setupDimensionOptions(uuid.NewV4().String(), s.data(instanceID, option)))
+		}
 	}
 
-	for _, o := range options {
-		docs = append(docs, setupDimensionOptions(uuid.NewV4().String(), o))
+	return docs
+}
+
+// seededDimensionOptions returns the options of each dimension of an instance
+// set up by setupMultipleDimensionsAndOptions
+func seededDimensionOptions() map[string][]string {
+	options := make(map[string][]string)
+	for _, s := range seededOptions {
+		options[s.dimension] = s.options
 	}
+	return options
+}
 
-	return docs
+// setupFilterBlueprint returns the documents of a filter blueprint against a
+// published instance with the seeded dimension options, along with the ids of
+// the blueprint and instance, and the dimensions the blueprint starts with
+func setupFilterBlueprint() (filterBlueprintID, instanceID string, docs []*mongo.Doc, initial []blueprint.Dimension) {
+	filterID := uuid.NewV4().String()
+	filterBlueprintID = uuid.NewV4().String()
+	instanceID = uuid.NewV4().String()
+	datasetID := uuid.NewV4().String()
+	edition := "2017"
+	version := 1
+
+	filter := &mongo.Doc{
+		Database:   cfg.MongoFiltersDB,
+		Collection: collection,
+		Key:        "_id",
+		Value:      filterID,
+		Update:     GetValidFilterWithMultipleDimensionsBSON(cfg.FilterAPIURL, filterID, instanceID, datasetID, edition, filterBlueprintID, version, true),
+	}
+
+	instance := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: "instances",
+		Key:        "instance_id",
+		Value:      instanceID,
+		Update:     GetValidPublishedInstanceDataBSON(instanceID, datasetID, edition, version),
+	}
+
+	docs = setupMultipleDimensionsAndOptions(instanceID)
+	docs = append(docs, filter, instance)
+
+	// the dimensions GetValidFilterWithMultipleDimensionsBSON creates the blueprint with
+	for _, d := range []Dimension{ageDimension("", ""), sexDimension("", ""), goodsAndServicesDimension("", ""), timeDimension("", "")} {
+		initial = append(initial, blueprint.Dimension{Name: d.Name, Options: d.Options})
+	}
+
+	return filterBlueprintID, instanceID, docs, initial
 }
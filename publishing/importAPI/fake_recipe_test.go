@@ -0,0 +1,275 @@
+package importAPI
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect"
+	"github.com/globalsign/mgo"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/recipeAPIModels"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/recipe"
+	importAPIModel "github.com/ONSdigital/dp-import-api/models"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+const unknownCodeListID = "dp-api-tests-unknown-code-list"
+
+func TestCreateJobWithFakeRecipes(t *testing.T) {
+
+	multipleOutputs := multipleOutputsRecipe()
+	unknownCodeList := unknownCodeListRecipe()
+	malformedRecipeID := uuid.NewV4().String()
+	missingRecipeID := uuid.NewV4().String()
+
+	fakeRecipeAPI := startFakeRecipeAPI(t, multipleOutputs, unknownCodeList)
+	defer stopFakeRecipeAPI(fakeRecipeAPI)
+
+	fakeRecipeAPI.AddMalformed(malformedRecipeID, []byte(`{"id":"`+malformedRecipeID+`","output_instances":"cpih01"`))
+
+	importAPI := httpexpect.New(t, cfg.ImportAPIFakeRecipesURL)
+
+	Convey("Given a recipe with more than one output instance", t, func() {
+		Convey("When create job is called", func() {
+			job := createJobForRecipe(importAPI, multipleOutputs.ID)
+
+			Convey("Then an instance is created for each output instance of the recipe", func() {
+				So(job.Links.Instances, ShouldHaveLength, len(multipleOutputs.OutputInstances))
+
+				outputs := make(map[string]recipeAPIModels.Instance)
+				for _, output := range multipleOutputs.OutputInstances {
+					outputs[output.DatasetID] = output
+				}
+
+				for _, link := range job.Links.Instances {
+					instance := getJobInstance(job.ID, link.ID)
+
+					So(instance.Links.Dataset, ShouldNotBeNil)
+					output, ok := outputs[instance.Links.Dataset.ID]
+					So(ok, ShouldBeTrue)
+					checkInstanceDimensions(instance, output.CodeLists)
+
+					// each output instance is used exactly once
+					delete(outputs, instance.Links.Dataset.ID)
+				}
+
+				So(outputs, ShouldBeEmpty)
+			})
+
+			Reset(func() {
+				teardownJobForRecipe(job)
+			})
+		})
+	})
+
+	Convey("Given a recipe with a code list the code list API does not hold", t, func() {
+		Convey("When create job is called", func() {
+			job := createJobForRecipe(importAPI, unknownCodeList.ID)
+
+			Convey("Then the instance is created with the code list as a dimension", func() {
+				So(job.Links.Instances, ShouldHaveLength, 1)
+
+				instance := getJobInstance(job.ID, job.Links.Instances[0].ID)
+				checkInstanceDimensions(instance, unknownCodeList.OutputInstances[0].CodeLists)
+			})
+
+			Reset(func() {
+				teardownJobForRecipe(job)
+			})
+		})
+	})
+
+	Convey("Given a recipe that cannot be decoded", t, func() {
+		Convey("When create job is called", func() {
+			Convey("Then the job is not created", func() {
+				checkJobNotCreated(importAPI, malformedRecipeID)
+			})
+		})
+	})
+
+	Convey("Given a recipe the recipe API does not hold", t, func() {
+		Convey("When create job is called", func() {
+			Convey("Then the job is not created", func() {
+				checkJobNotCreated(importAPI, missingRecipeID)
+			})
+		})
+	})
+}
+
+// startFakeRecipeAPI starts a fake recipe API serving the recipes, skipping the
+// test unless an import API has been configured against the fake
+func startFakeRecipeAPI(t *testing.T, recipes ...recipeAPIModels.Recipe) *recipe.FakeAPI {
+	if cfg.ImportAPIFakeRecipesURL == "" {
+		t.Skip("IMPORT_API_FAKE_RECIPES_URL is not set")
+	}
+
+	fakeRecipeAPI, err := recipe.NewFakeAPI(recipes...)
+	if err != nil {
+		log.ErrorC("Unable to create fake recipe API", err, nil)
+		t.FailNow()
+	}
+
+	if err = fakeRecipeAPI.Start(cfg.FakeRecipeAPIBindAddr); err != nil {
+		log.ErrorC("Unable to start fake recipe API", err, log.Data{"bind_addr": cfg.FakeRecipeAPIBindAddr})
+		t.FailNow()
+	}
+
+	return fakeRecipeAPI
+}
+
+func stopFakeRecipeAPI(fakeRecipeAPI *recipe.FakeAPI) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := fakeRecipeAPI.Close(ctx); err != nil {
+		log.ErrorC("Unable to stop fake recipe API", err, nil)
+	}
+}
+
+func multipleOutputsRecipe() recipeAPIModels.Recipe {
+	return recipeAPIModels.Recipe{
+		ID:         uuid.NewV4().String(),
+		Alias:      "Multiple outputs",
+		Format:     "v4",
+		InputFiles: []recipeAPIModels.File{{Description: "v4"}},
+		OutputInstances: []recipeAPIModels.Instance{
+			{
+				DatasetID: "dp-api-tests-multiple-outputs-first",
+				Editions:  []string{"time-series"},
+				Title:     "First output",
+				CodeLists: []recipeAPIModels.CodeList{
+					fakeCodeList("mmm-yy", "time", false),
+					fakeCodeList("uk-only", "geography", false),
+				},
+			},
+			{
+				DatasetID: "dp-api-tests-multiple-outputs-second",
+				Editions:  []string{"2018"},
+				Title:     "Second output",
+				CodeLists: []recipeAPIModels.CodeList{
+					fakeCodeList("mmm-yy", "time", false),
+					fakeCodeList("cpih1dim1aggid", "aggregate", true),
+				},
+			},
+		},
+	}
+}
+
+func unknownCodeListRecipe() recipeAPIModels.Recipe {
+	return recipeAPIModels.Recipe{
+		ID:         uuid.NewV4().String(),
+		Alias:      "Unknown code list",
+		Format:     "v4",
+		InputFiles: []recipeAPIModels.File{{Description: "v4"}},
+		OutputInstances: []recipeAPIModels.Instance{
+			{
+				DatasetID: "dp-api-tests-unknown-code-list",
+				Editions:  []string{"time-series"},
+				Title:     "Unknown code list",
+				CodeLists: []recipeAPIModels.CodeList{
+					fakeCodeList("mmm-yy", "time", false),
+					fakeCodeList(unknownCodeListID, "unknown", false),
+				},
+			},
+		},
+	}
+}
+
+func fakeCodeList(id, name string, isHierarchy bool) recipeAPIModels.CodeList {
+	return recipeAPIModels.CodeList{
+		ID:          id,
+		HRef:        cfg.CodeListAPIURL + "/code-lists/" + id,
+		Name:        name,
+		IsHierarchy: isHierarchy,
+	}
+}
+
+func createJobForRecipe(importAPI *httpexpect.Expect, recipeID string) importAPIModel.Job {
+	files := []importAPIModel.UploadedFile{{AliasName: "v4", URL: v4FileURL}}
+
+	id := importAPI.POST("/jobs").
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		WithJSON(importAPIModel.Job{RecipeID: recipeID, State: jobStateCreated, UploadedFiles: &files}).
+		Expect().Status(http.StatusCreated).
+		JSON().Object().Value("id").String().Raw()
+
+	job, err := mongo.GetJob(cfg.MongoImportsDB, collection, "id", id)
+	So(err, ShouldBeNil)
+	So(job.RecipeID, ShouldEqual, recipeID)
+	return job
+}
+
+func getJobInstance(jobID, instanceID string) mongo.Instance {
+	instance, err := mongo.GetInstance(cfg.MongoDB, "instances", "id", instanceID)
+	So(err, ShouldBeNil)
+	So(instance.Links.Job, ShouldNotBeNil)
+	So(instance.Links.Job.ID, ShouldEqual, jobID)
+	return instance
+}
+
+// checkInstanceDimensions checks the instance has a dimension for each code list
+// of the output instance it was created from, and no others
+func checkInstanceDimensions(instance mongo.Instance, codeLists []recipeAPIModels.CodeList) {
+	So(instance.Dimensions, ShouldHaveLength, len(codeLists))
+
+	dimensions := make(map[string]mongo.CodeList)
+	for _, dimension := range instance.Dimensions {
+		dimensions[dimension.ID] = dimension
+	}
+
+	for _, codeList := range codeLists {
+		dimension, ok := dimensions[codeList.ID]
+		So(ok, ShouldBeTrue)
+		So(dimension.HRef, ShouldEqual, codeList.HRef)
+		So(dimension.Name, ShouldEqual, codeList.Name)
+	}
+}
+
+// checkJobNotCreated checks the import API refuses a job for the recipe and
+// stores nothing for it
+func checkJobNotCreated(importAPI *httpexpect.Expect, recipeID string) {
+	files := []importAPIModel.UploadedFile{{AliasName: "v4", URL: v4FileURL}}
+
+	response := importAPI.POST("/jobs").
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		WithJSON(importAPIModel.Job{RecipeID: recipeID, State: jobStateCreated, UploadedFiles: &files}).
+		Expect()
+
+	So(response.Raw().StatusCode, ShouldBeGreaterThanOrEqualTo, http.StatusBadRequest)
+
+	_, err := mongo.GetJob(cfg.MongoImportsDB, collection, "recipe", recipeID)
+	So(err, ShouldEqual, mgo.ErrNotFound)
+}
+
+func teardownJobForRecipe(job importAPIModel.Job) {
+	docs := []*mongo.Doc{
+		{
+			Database:   cfg.MongoImportsDB,
+			Collection: collection,
+			Key:        "id",
+			Value:      job.ID,
+		},
+	}
+
+	for _, link := range job.Links.Instances {
+		docs = append(docs, &mongo.Doc{
+			Database:   cfg.MongoDB,
+			Collection: "instances",
+			Key:        "id",
+			Value:      link.ID,
+		})
+	}
+
+	if err := mongo.Teardown(docs...); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("Failed to tear down test data", err, nil)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,374 @@
+package importAPI
+
+import (
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	"github.com/globalsign/mgo"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/recipeAPIModels"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	importAPIModel "github.com/ONSdigital/dp-import-api/models"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// Possible states of an import job
+const (
+	jobStateCreated   = "created"
+	jobStateSubmitted = "submitted"
+	jobStateCompleted = "completed"
+	jobStateFailed    = "failed"
+)
+
+// the files are only stored against jobs, so the replacement and notes files
+// need not exist
+const (
+	v4FileURL         = "https://s3-eu-west-1.amazonaws.com/dp-publish-content-test/OCIGrowth.csv"
+	metadataFileURL   = "https://s3-eu-west-1.amazonaws.com/dp-publish-content-test/OCIGrowth-metadata.json"
+	replacedV4FileURL = "https://s3-eu-west-1.amazonaws.com/dp-publish-content-test/OCIGrowth-replacement.csv"
+	notesFileURL      = "https://s3-eu-west-1.amazonaws.com/dp-publish-content-test/OCIGrowth-notes.txt"
+)
+
+// TestImportJobLifecycle creates jobs against a recipe of the fake recipe API,
+// so no real dataset is imported when they are submitted. Once a job is submitted its instances
+// are moved on by the import services, so only the state of the job, which is
+// controlled by the import API alone, is checked from then on.
+func TestImportJobLifecycle(t *testing.T) {
+
+	lifecycle := lifecycleRecipe()
+	fakeRecipeAPI := startFakeRecipeAPI(t, lifecycle)
+	defer stopFakeRecipeAPI(fakeRecipeAPI)
+
+	importAPI := httpexpect.New(t, cfg.ImportAPIFakeRecipesURL)
+
+	Convey("Given a job is created with more than one file", t, func() {
+		job := createLifecycleJob(importAPI, lifecycle.ID)
+		instanceIDs := linkedInstanceIDs(job)
+
+		So(job.State, ShouldEqual, jobStateCreated)
+		So(*job.UploadedFiles, ShouldHaveLength, 2)
+		So(instanceIDs, ShouldNotBeEmpty)
+		checkLinkedInstances(job.ID, instanceIDs)
+		checkInstanceStates(instanceIDs, jobStateCreated)
+
+		Convey("When a file with a new alias is added to the job", func() {
+			addJobFile(importAPI, job.ID, "notes", notesFileURL)
+
+			Convey("Then the file is added alongside the existing files", func() {
+				files := getJobFiles(job.ID)
+				So(files, ShouldHaveLength, 3)
+				So(files["v4"], ShouldEqual, v4FileURL)
+				So(files["metadata"], ShouldEqual, metadataFileURL)
+				So(files["notes"], ShouldEqual, notesFileURL)
+				checkLinkedInstances(job.ID, instanceIDs)
+				checkInstanceStates(instanceIDs, jobStateCreated)
+
+				Convey("When a file with an existing alias is added to the job", func() {
+					addJobFile(importAPI, job.ID, "v4", replacedV4FileURL)
+
+					Convey("Then the file replaces the file with the same alias", func() {
+						files := getJobFiles(job.ID)
+						So(files, ShouldHaveLength, 3)
+						So(files["v4"], ShouldEqual, replacedV4FileURL)
+						checkLinkedInstances(job.ID, instanceIDs)
+						checkInstanceStates(instanceIDs, jobStateCreated)
+
+						Convey("When the job is submitted", func() {
+							updateJobState(importAPI, job.ID, jobStateSubmitted).Expect().Status(http.StatusOK)
+
+							Convey("Then the job is submitted and its instances remain linked to it", func() {
+								So(getJobState(job.ID), ShouldEqual, jobStateSubmitted)
+								checkLinkedInstances(job.ID, instanceIDs)
+								checkJobListedByState(importAPI, job.ID, jobStateSubmitted, jobStateCreated)
+
+								Convey("When the job is completed", func() {
+									updateJobState(importAPI, job.ID, jobStateCompleted).Expect().Status(http.StatusOK)
+
+									Convey("Then the job is completed and its instances remain linked to it", func() {
+										So(getJobState(job.ID), ShouldEqual, jobStateCompleted)
+										checkLinkedInstances(job.ID, instanceIDs)
+										checkJobListedByState(importAPI, job.ID, jobStateCompleted, jobStateSubmitted)
+									})
+								})
+							})
+						})
+					})
+				})
+			})
+		})
+
+		Reset(func() {
+			teardownLifecycleJob(job)
+		})
+	})
+
+	Convey("Given a job has been submitted", t, func() {
+		job := createLifecycleJob(importAPI, lifecycle.ID)
+		instanceIDs := linkedInstanceIDs(job)
+		updateJobState(importAPI, job.ID, jobStateSubmitted).Expect().Status(http.StatusOK)
+
+		Convey("When the job fails", func() {
+			updateJobState(importAPI, job.ID, jobStateFailed).Expect().Status(http.StatusOK)
+
+			Convey("Then the job is failed and its instances remain linked to it", func() {
+				So(getJobState(job.ID), ShouldEqual, jobStateFailed)
+				checkLinkedInstances(job.ID, instanceIDs)
+				checkJobListedByState(importAPI, job.ID, jobStateFailed, jobStateSubmitted)
+			})
+		})
+
+		Reset(func() {
+			teardownLifecycleJob(job)
+		})
+	})
+}
+
+func TestImportJobInvalidStateTransitions(t *testing.T) {
+
+	lifecycle := lifecycleRecipe()
+	fakeRecipeAPI := startFakeRecipeAPI(t, lifecycle)
+	defer stopFakeRecipeAPI(fakeRecipeAPI)
+
+	importAPI := httpexpect.New(t, cfg.ImportAPIFakeRecipesURL)
+
+	// each transition is from the state the job is moved through first
+	transitions := []struct {
+		description string
+		through     []string
+		to          string
+	}{
+		{"a created job is completed without being submitted", nil, jobStateCompleted},
+		{"a created job is moved to an unknown state", nil, "unknown"},
+		{"a submitted job is moved back to created", []string{jobStateSubmitted}, jobStateCreated},
+		{"a completed job is moved back to submitted", []string{jobStateSubmitted, jobStateCompleted}, jobStateSubmitted},
+		{"a completed job is failed", []string{jobStateSubmitted, jobStateCompleted}, jobStateFailed},
+		{"a failed job is completed", []string{jobStateSubmitted, jobStateFailed}, jobStateCompleted},
+	}
+
+	for _, transition := range transitions {
+		transition := transition
+
+		Convey("Given "+transition.description, t, func() {
+			job := createLifecycleJob(importAPI, lifecycle.ID)
+			instanceIDs := linkedInstanceIDs(job)
+
+			state := jobStateCreated
+			for _, s := range transition.through {
+				updateJobState(importAPI, job.ID, s).Expect().Status(http.StatusOK)
+				state = s
+			}
+
+			Convey("When update job is called", func() {
+				updateJobState(importAPI, job.ID, transition.to).
+					Expect().Status(http.StatusBadRequest)
+
+				Convey("Then the job and its instances are left unchanged", func() {
+					So(getJobState(job.ID), ShouldEqual, state)
+					checkLinkedInstances(job.ID, instanceIDs)
+					if state == jobStateCreated {
+						checkInstanceStates(instanceIDs, jobStateCreated)
+					}
+				})
+			})
+
+			Reset(func() {
+				teardownLifecycleJob(job)
+			})
+		})
+	}
+
+	Convey("Given a job has been submitted", t, func() {
+		job := createLifecycleJob(importAPI, lifecycle.ID)
+		updateJobState(importAPI, job.ID, jobStateSubmitted).Expect().Status(http.StatusOK)
+
+		Convey("When a file is added to the job", func() {
+			importAPI.PUT("/jobs/{id}/files", job.ID).
+				WithHeader(serviceAuthTokenName, serviceAuthToken).
+				WithJSON(importAPIModel.UploadedFile{AliasName: "notes", URL: notesFileURL}).
+				Expect().Status(http.StatusForbidden)
+
+			Convey("Then the files of the job are left unchanged", func() {
+				So(getJobFiles(job.ID), ShouldHaveLength, 2)
+			})
+		})
+
+		Reset(func() {
+			teardownLifecycleJob(job)
+		})
+	})
+
+	Convey("Given a job exists", t, func() {
+		job := createLifecycleJob(importAPI, lifecycle.ID)
+
+		Convey("When a file without an alias is added to the job", func() {
+			importAPI.PUT("/jobs/{id}/files", job.ID).
+				WithHeader(serviceAuthTokenName, serviceAuthToken).
+				WithJSON(importAPIModel.UploadedFile{URL: notesFileURL}).
+				Expect().Status(http.StatusBadRequest)
+
+			Convey("Then the files of the job are left unchanged", func() {
+				So(getJobFiles(job.ID), ShouldHaveLength, 2)
+			})
+		})
+
+		Reset(func() {
+			teardownLifecycleJob(job)
+		})
+	})
+}
+
+// lifecycleRecipe returns a recipe with a single output instance for jobs to be
+// moved through their states against
+func lifecycleRecipe() recipeAPIModels.Recipe {
+	return recipeAPIModels.Recipe{
+		ID:         uuid.NewV4().String(),
+		Alias:      "Job lifecycle",
+		Format:     "v4",
+		InputFiles: []recipeAPIModels.File{{Description: "v4"}},
+		OutputInstances: []recipeAPIModels.Instance{
+			{
+				DatasetID: "dp-api-tests-job-lifecycle",
+				Editions:  []string{"time-series"},
+				Title:     "Job lifecycle",
+				CodeLists: []recipeAPIModels.CodeList{
+					fakeCodeList("mmm-yy", "time", false),
+				},
+			},
+		},
+	}
+}
+
+// createLifecycleJob creates a job for the recipe with a v4 and a metadata
+// file, returning the job as stored in mongo
+func createLifecycleJob(importAPI *httpexpect.Expect, recipeID string) importAPIModel.Job {
+	files := []importAPIModel.UploadedFile{
+		{AliasName: "v4", URL: v4FileURL},
+		{AliasName: "metadata", URL: metadataFileURL},
+	}
+
+	response := importAPI.POST("/jobs").
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		WithJSON(importAPIModel.Job{RecipeID: recipeID, State: jobStateCreated, UploadedFiles: &files}).
+		Expect().Status(http.StatusCreated).
+		JSON().Object()
+
+	id := response.Value("id").String().Raw()
+	response.Value("links").Object().Value("self").Object().Value("href").String().Match("(.+)/jobs/" + id + "$")
+
+	job, err := mongo.GetJob(cfg.MongoImportsDB, collection, "id", id)
+	So(err, ShouldBeNil)
+	return job
+}
+
+func linkedInstanceIDs(job importAPIModel.Job) []string {
+	var ids []string
+	for _, instance := range job.Links.Instances {
+		ids = append(ids, instance.ID)
+	}
+	return ids
+}
+
+func addJobFile(importAPI *httpexpect.Expect, jobID, alias, url string) {
+	importAPI.PUT("/jobs/{id}/files", jobID).
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		WithJSON(importAPIModel.UploadedFile{AliasName: alias, URL: url}).
+		Expect().Status(http.StatusOK)
+}
+
+func updateJobState(importAPI *httpexpect.Expect, jobID, state string) *httpexpect.Request {
+	return importAPI.PUT("/jobs/{id}", jobID).
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		WithJSON(map[string]string{"state": state})
+}
+
+// getJobFiles returns the url of each file of a job keyed by alias
+func getJobFiles(jobID string) map[string]string {
+	job, err := mongo.GetJob(cfg.MongoImportsDB, collection, "id", jobID)
+	So(err, ShouldBeNil)
+	So(job.UploadedFiles, ShouldNotBeNil)
+
+	files := make(map[string]string)
+	for _, file := range *job.UploadedFiles {
+		_, duplicate := files[file.AliasName]
+		So(duplicate, ShouldBeFalse)
+		files[file.AliasName] = file.URL
+	}
+	return files
+}
+
+func getJobState(jobID string) string {
+	job, err := mongo.GetJob(cfg.MongoImportsDB, collection, "id", jobID)
+	So(err, ShouldBeNil)
+	So(job.UniqueTimestamp, ShouldNotBeEmpty)
+	return job.State
+}
+
+// checkLinkedInstances checks every instance of a job links back to the job
+func checkLinkedInstances(jobID string, instanceIDs []string) {
+	for _, id := range instanceIDs {
+		instance, err := mongo.GetInstance(cfg.MongoDB, "instances", "id", id)
+		So(err, ShouldBeNil)
+
+		So(instance.Links.Job, ShouldNotBeNil)
+		So(instance.Links.Job.ID, ShouldEqual, jobID)
+		So(instance.Links.Job.HRef, ShouldEndWith, "/jobs/"+jobID)
+	}
+}
+
+// checkInstanceStates checks every instance is in the given state
+func checkInstanceStates(instanceIDs []string, state string) {
+	for _, id := range instanceIDs {
+		instance, err := mongo.GetInstance(cfg.MongoDB, "instances", "id", id)
+		So(err, ShouldBeNil)
+		So(instance.State, ShouldEqual, state)
+	}
+}
+
+// checkJobListedByState checks the job is listed when jobs are filtered by its
+// state, and is not listed when filtered by a previous state
+func checkJobListedByState(importAPI *httpexpect.Expect, jobID, state, previousState string) {
+	importAPI.GET("/jobs").WithQuery("state", state).
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		Expect().Status(http.StatusOK).
+		JSON().Path("$[*].id").Array().Contains(jobID)
+
+	response := importAPI.GET("/jobs").WithQuery("state", previousState).
+		WithHeader(serviceAuthTokenName, serviceAuthToken).
+		Expect()
+
+	// no jobs in the previous state is returned as not found
+	if response.Raw().StatusCode == http.StatusNotFound {
+		return
+	}
+	response.Status(http.StatusOK).JSON().Path("$[*].id").Array().NotContains(jobID)
+}
+
+// teardownLifecycleJob removes the job and every instance created for it
+func teardownLifecycleJob(job importAPIModel.Job) {
+	docs := []*mongo.Doc{{
+		Database:   cfg.MongoImportsDB,
+		Collection: collection,
+		Key:        "id",
+		Value:      job.ID,
+	}}
+
+	for _, id := range linkedInstanceIDs(job) {
+		docs = append(docs, &mongo.Doc{
+			Database:   cfg.MongoDB,
+			Collection: "instances",
+			Key:        "id",
+			Value:      id,
+		})
+	}
+
+	if err := mongo.Teardown(docs...); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("Failed to tear down test data", err, nil)
+			os.Exit(1)
+		}
+	}
+}
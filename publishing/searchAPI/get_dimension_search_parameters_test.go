@@ -0,0 +1,190 @@
+package searchAPI
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/helpers"
+	"github.com/ONSdigital/dp-api-tests/helpers/assertions"
+	"github.com/ONSdigital/dp-api-tests/publishing/datasetAPI"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/elasticsearch"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/common"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// searchTerm matches searchTermTotal documents in testDataSetup/elasticsearch/testData.json
+const (
+	searchTerm      = "Furniture and furnishings"
+	searchTermTotal = 7
+)
+
+func TestSearchQueryParameters(t *testing.T) {
+	unpublishedDatasetID := uuid.NewV4().String()
+	publishedDatasetID := uuid.NewV4().String()
+	unpublishedEditionID := uuid.NewV4().String()
+	publishedEditionID := uuid.NewV4().String()
+	publishedInstanceID := uuid.NewV4().String()
+	edition := "2017"
+
+	uniqueTimestamp, err := bson.NewMongoTimestamp(time.Now().UTC(), 1)
+	if err != nil {
+		log.ErrorC("unable to generate mongo timestamp", err, nil)
+		t.FailNow()
+	}
+
+	docs := []*mongo.Doc{
+		{
+			Database:   cfg.MongoDB,
+			Collection: collection,
+			Key:        "_id",
+			Value:      unpublishedDatasetID,
+			Update:     validAssociatedDatasetData(unpublishedDatasetID),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "editions",
+			Key:        "_id",
+			Value:      unpublishedEditionID,
+			Update:     datasetAPI.ValidUnpublishedEditionData(unpublishedDatasetID, unpublishedEditionID, edition),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "instances",
+			Key:        "_id",
+			Value:      instanceID,
+			Update:     validAssociatedInstanceData(unpublishedDatasetID, edition, instanceID, uniqueTimestamp),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: collection,
+			Key:        "_id",
+			Value:      publishedDatasetID,
+			Update:     datasetAPI.ValidPublishedWithUpdatesDatasetData(publishedDatasetID),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "editions",
+			Key:        "_id",
+			Value:      publishedEditionID,
+			Update:     datasetAPI.ValidPublishedEditionData(publishedDatasetID, publishedEditionID, edition),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "instances",
+			Key:        "_id",
+			Value:      publishedInstanceID,
+			Update:     validPublishedInstanceData(publishedDatasetID, edition, publishedInstanceID, uniqueTimestamp),
+		},
+	}
+
+	if err = mongo.Setup(docs...); err != nil {
+		log.ErrorC("was unable to run test", err, nil)
+		t.FailNow()
+	}
+
+	for _, id := range []string{instanceID, publishedInstanceID} {
+		if err = createSearchIndex(cfg.ElasticSearchAPIURL, id, dimensionKeyAggregate); err != nil {
+			log.ErrorC("Unable to setup elasticsearch index with test data", err, nil)
+			t.FailNow()
+		}
+	}
+
+	searchAPI := httpexpect.New(t, cfg.SearchAPIURL)
+	path := "/search/datasets/{datasetID}/editions/{edition}/versions/{version}/dimensions/{dimension}"
+
+	Convey("Given an existing version for an edition of a dataset is associated", t, func() {
+		for _, c := range helpers.SearchParameterCases(searchTerm, searchTermTotal, helpers.SearchMaxResults) {
+			c := c
+
+			Convey("When an authenticated GET request is made with "+c.Description, func() {
+				request := searchAPI.GET(path, unpublishedDatasetID, edition, "1", dimensionKeyAggregate).
+					WithHeader(common.AuthHeaderKey, serviceToken)
+
+				assertions.CheckSearchParameterCase(request, c)
+			})
+		}
+	})
+
+	Convey("Given an existing version for an edition of a dataset is published", t, func() {
+		Convey("When an authenticated GET request is made with a search term", func() {
+			Convey("Then the response returns a list of results with a status ok (200)", func() {
+
+				response := searchAPI.GET(path, publishedDatasetID, edition, "1", dimensionKeyAggregate).
+					WithQuery("q", searchTerm).
+					WithHeader(common.AuthHeaderKey, serviceToken).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("count").Equal(searchTermTotal)
+				response.Value("items").Array().Length().Equal(searchTermTotal)
+			})
+		})
+	})
+
+	Convey("Given existing published and associated versions", t, func() {
+		for _, datasetID := range []string{unpublishedDatasetID, publishedDatasetID} {
+			datasetID := datasetID
+
+			Convey("When a GET request is made without an authentication header for dataset "+datasetID, func() {
+				Convey("Then the response returns unauthorized (401)", func() {
+
+					searchAPI.GET(path, datasetID, edition, "1", dimensionKeyAggregate).
+						WithQuery("q", searchTerm).
+						Expect().Status(http.StatusUnauthorized)
+				})
+			})
+		}
+	})
+
+	Convey("Given an existing version for an edition of a dataset is associated", t, func() {
+		Convey("When an authenticated GET request is made for a dimension that is not indexed", func() {
+			Convey("Then the response returns not found (404)", func() {
+
+				searchAPI.GET(path, unpublishedDatasetID, edition, "1", "unknown").
+					WithQuery("q", searchTerm).
+					WithHeader(common.AuthHeaderKey, serviceToken).
+					Expect().Status(http.StatusNotFound).Body().Contains("search index not found\n")
+			})
+		})
+
+		Convey("When the search index is missing and an authenticated GET request is made", func() {
+			if _, err := elasticsearch.DeleteIndex(cfg.ElasticSearchAPIURL + "/" + publishedInstanceID + "_" + dimensionKeyAggregate); err != nil {
+				log.ErrorC("failed to remove elastic search index", err, nil)
+				t.FailNow()
+			}
+
+			Convey("Then the response returns not found (404)", func() {
+
+				searchAPI.GET(path, publishedDatasetID, edition, "1", dimensionKeyAggregate).
+					WithQuery("q", searchTerm).
+					WithHeader(common.AuthHeaderKey, serviceToken).
+					Expect().Status(http.StatusNotFound).Body().Contains("search index not found\n")
+			})
+		})
+	})
+
+	if skipTeardown {
+		return
+	}
+
+	if err = mongo.Teardown(docs...); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("was unable to remove test data", err, nil)
+			t.FailNow()
+		}
+	}
+
+	for _, id := range []string{instanceID, publishedInstanceID} {
+		if status, err := elasticsearch.DeleteIndex(cfg.ElasticSearchAPIURL + "/" + id + "_" + dimensionKeyAggregate); err != nil && status != http.StatusNotFound {
+			log.ErrorC("failed to remove elastic search index", err, log.Data{"status_code": status})
+			t.FailNow()
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package searchAPI
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/publishing/datasetAPI"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/elasticsearch"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/common"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// updateRelevance rewrites the golden results with the results returned by the
+// search API, run with -update-relevance after an intended change to relevance
+var updateRelevance = flag.Bool("update-relevance", false, "update the golden search relevance results")
+
+const relevanceFile = "/relevance.json"
+
+type relevanceCase struct {
+	Description string            `json:"description"`
+	Query       string            `json:"q"`
+	K           int               `json:"k"`
+	Count       int               `json:"count"`
+	Expected    []relevanceResult `json:"expected"`
+}
+
+type relevanceResult struct {
+	Code    string                      `json:"code"`
+	Matches map[string][]relevanceMatch `json:"matches"`
+}
+
+type relevanceMatch struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type searchResults struct {
+	Count int               `json:"count"`
+	Items []relevanceResult `json:"items"`
+}
+
+func TestSearchRelevance(t *testing.T) {
+	datasetID := uuid.NewV4().String()
+	editionID := uuid.NewV4().String()
+	edition := "2017"
+
+	uniqueTimestamp, err := bson.NewMongoTimestamp(time.Now().UTC(), 1)
+	if err != nil {
+		log.ErrorC("unable to generate mongo timestamp", err, nil)
+		t.FailNow()
+	}
+
+	directory, err := testDataDirectory()
+	if err != nil {
+		log.ErrorC("unable to find test data directory", err, nil)
+		t.FailNow()
+	}
+
+	cases, err := readRelevanceCases(directory + relevanceFile)
+	if err != nil {
+		log.ErrorC("unable to read search relevance cases", err, nil)
+		t.FailNow()
+	}
+
+	datasetDoc := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: collection,
+		Key:        "_id",
+		Value:      datasetID,
+		Update:     validAssociatedDatasetData(datasetID),
+	}
+
+	editionDoc := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: "editions",
+		Key:        "_id",
+		Value:      editionID,
+		Update:     datasetAPI.ValidUnpublishedEditionData(datasetID, editionID, edition),
+	}
+
+	versionDoc := &mongo.Doc{
+		Database:   cfg.MongoDB,
+		Collection: "instances",
+		Key:        "_id",
+		Value:      instanceID,
+		Update:     validAssociatedInstanceData(datasetID, edition, instanceID, uniqueTimestamp),
+	}
+
+	if err = mongo.Setup(datasetDoc, editionDoc, versionDoc); err != nil {
+		log.ErrorC("was unable to run test", err, nil)
+		t.FailNow()
+	}
+
+	// the index is refreshed once loaded so results are available immediately
+	if err = createRelevanceIndex(cfg.ElasticSearchAPIURL, instanceID, dimensionKeyAggregate); err != nil {
+		log.ErrorC("Unable to setup elasticsearch index with test data", err, nil)
+		t.FailNow()
+	}
+
+	searchAPI := httpexpect.New(t, cfg.SearchAPIURL)
+	precision := make(map[string]float64)
+	var actual []*relevanceCase
+
+	Convey("Given an existing version for an edition of a dataset is associated and indexed", t, func() {
+		for _, c := range cases {
+			c := c
+
+			Convey("When a GET request is made with a query term for "+c.Description+": "+c.Query, func() {
+				body := searchAPI.GET("/search/datasets/{datasetID}/editions/{edition}/versions/{version}/dimensions/{dimension}", datasetID, edition, "1", dimensionKeyAggregate).
+					WithQuery("q", c.Query).
+					WithHeader(common.AuthHeaderKey, serviceToken).
+					Expect().Status(http.StatusOK).Body().Raw()
+
+				var results searchResults
+				So(json.Unmarshal([]byte(body), &results), ShouldBeNil)
+
+				top := results.Items
+				if len(top) > c.K {
+					top = top[:c.K]
+				}
+
+				precision[c.Description] = precisionAtK(c.Expected, top, c.K)
+				actual = append(actual, &relevanceCase{
+					Description: c.Description,
+					Query:       c.Query,
+					K:           c.K,
+					Count:       results.Count,
+					Expected:    top,
+				})
+
+				if *updateRelevance {
+					return
+				}
+
+				Convey("Then the top results are returned in the expected order with the expected matches", func() {
+					So(results.Count, ShouldEqual, c.Count)
+					So(codes(top), ShouldResemble, codes(c.Expected))
+
+					for i := range c.Expected {
+						if i < len(top) {
+							So(top[i].Matches, ShouldResemble, c.Expected[i].Matches)
+						}
+					}
+				})
+			})
+		}
+	})
+
+	reportPrecision(cases, precision)
+
+	if *updateRelevance {
+		if err = writeRelevanceCases(directory+relevanceFile, actual); err != nil {
+			log.ErrorC("unable to update search relevance cases", err, nil)
+			t.FailNow()
+		}
+	}
+
+	if err = mongo.Teardown(datasetDoc, editionDoc, versionDoc); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("was unable to remove test data", err, nil)
+			t.FailNow()
+		}
+	}
+
+	path := cfg.ElasticSearchAPIURL + "/" + instanceID + "_" + dimensionKeyAggregate
+	if status, err := elasticsearch.DeleteIndex(path); err != nil {
+		log.ErrorC("failed to remove elastic search index", err, log.Data{"status_code": status})
+		t.FailNow()
+	}
+}
+
+// precisionAtK returns the proportion of the top k results that are within the
+// expected top k results, ignoring their order. A case with no expected
+// results scores 1 only when nothing is returned.
+func precisionAtK(expected, actual []relevanceResult, k int) float64 {
+	if len(expected) < k {
+		k = len(expected)
+	}
+
+	if k == 0 {
+		if len(actual) == 0 {
+			return 1
+		}
+		return 0
+	}
+
+	relevant := make(map[string]bool)
+	for _, e := range expected[:k] {
+		relevant[e.Code] = true
+	}
+
+	var found int
+	for i, a := range actual {
+		if i == k {
+			break
+		}
+		if relevant[a.Code] {
+			found++
+		}
+	}
+
+	return float64(found) / float64(k)
+}
+
+// reportPrecision logs the precision@k of every case and the mean across all
+// cases, so a change in the search API that drops relevant results can be
+// told apart from one that only reorders them
+func reportPrecision(cases []*relevanceCase, precision map[string]float64) {
+	var total float64
+	var changed []string
+	for _, c := range cases {
+		p, ok := precision[c.Description]
+		if !ok {
+			continue
+		}
+		total += p
+		if p < 1 {
+			changed = append(changed, c.Description)
+		}
+	}
+
+	var mean float64
+	if len(precision) > 0 {
+		mean = total / float64(len(precision))
+	}
+
+	log.Info("search relevance precision@k", log.Data{
+		"cases":          len(precision),
+		"mean_precision": mean,
+		"precision":      precision,
+		"changed_cases":  changed,
+	})
+}
+
+func codes(results []relevanceResult) []string {
+	codes := []string{}
+	for _, r := range results {
+		codes = append(codes, r.Code)
+	}
+	return codes
+}
+
+func readRelevanceCases(filename string) ([]*relevanceCase, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var cases []*relevanceCase
+	if err = json.Unmarshal(b, &cases); err != nil {
+		return nil, err
+	}
+
+	return cases, nil
+}
+
+func writeRelevanceCases(filename string, cases []*relevanceCase) error {
+	b, err := json.MarshalIndent(cases, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, append(b, '\n'), 0644)
+}
@@ -122,7 +122,7 @@ func validTimeDimensionsData(instanceID string) bson.M {
 	}
 }
 
-func validAssociatedInstanceData(datasetID, edition, instanceID string, uniqueTimestamp bson.MongoTimestamp) bson.M {
+func validPublishedInstanceData(datasetID, edition, instanceID string, uniqueTimestamp bson.MongoTimestamp) bson.M {
 	return bson.M{
 		"$set": bson.M{
 			"alerts":                      []mongo.Alert{alert},
@@ -160,3 +160,42 @@ func validAssociatedInstanceData(datasetID, edition, instanceID string, uniqueTi
 		},
 	}
 }
+
+func validAssociatedInstanceData(datasetID, edition, instanceID string, uniqueTimestamp bson.MongoTimestamp) bson.M {
+	return bson.M{
+		"$set": bson.M{
+			"alerts":                      []mongo.Alert{alert},
+			"collection_id":               "108064B3-A808-449B-9041-EA3A2F72CFAA",
+			"dimensions":                  []mongo.CodeList{dimension, dimensionTwo, dimensionThree},
+			"downloads.csv.url":           cfg.DatasetAPIURL + "/aws/census-2017-1-csv",
+			"downloads.csv.size":          "10",
+			"downloads.xls.url":           cfg.DatasetAPIURL + "/aws/census-2017-1-xls",
+			"downloads.xls.size":          "24",
+			"edition":                     edition,
+			"headers":                     []string{"time", "geography"},
+			"id":                          instanceID,
+			"latest_changes":              []mongo.LatestChange{latestChanges},
+			"last_updated":                "2017-09-08", // TODO Should be isodate
+			"license":                     "ONS License",
+			"links.job.id":                "042e216a-7822-4fa0-a3d6-e3f5248ffc35",
+			"links.job.href":              cfg.DatasetAPIURL + "/jobs/042e216a-7822-4fa0-a3d6-e3f5248ffc35",
+			"links.dataset.id":            datasetID,
+			"links.dataset.href":          cfg.DatasetAPIURL + "/datasets/" + datasetID,
+			"links.dimensions.href":       cfg.DatasetAPIURL + "/datasets/" + datasetID + "/editions/" + edition + "/versions/1/dimensions",
+			"links.edition.id":            edition,
+			"links.edition.href":          cfg.DatasetAPIURL + "/datasets/" + datasetID + "/editions/" + edition,
+			"links.self.href":             cfg.DatasetAPIURL + "/instances/" + instanceID,
+			"links.spatial.href":          "http://ons.gov.uk/geographylist",
+			"links.version.href":          cfg.DatasetAPIURL + "/datasets/" + datasetID + "/editions/" + edition + "/versions/1",
+			"links.version.id":            "1",
+			"release_date":                "2017-12-12", // TODO Should be isodate
+			"state":                       "associated",
+			"temporal":                    []mongo.TemporalFrequency{temporal},
+			"total_inserted_observations": 1000,
+			"total_observations":          1000,
+			"version":                     1,
+			"unique_timestamp":            uniqueTimestamp,
+			"test_data":                   "true",
+		},
+	}
+}
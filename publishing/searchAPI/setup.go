@@ -7,10 +7,12 @@ import (
 	"github.com/ONSdigital/dp-api-tests/testDataSetup/elasticsearch"
 )
 
-func createSearchIndex(url, instanceID, dimension string) error {
+// testDataDirectory returns the path to the test data set up directory from
+// the directory the tests are run in
+func testDataDirectory() (string, error) {
 	currentPath, err := os.Getwd()
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	directory := "../.."
@@ -18,12 +20,32 @@ func createSearchIndex(url, instanceID, dimension string) error {
 		directory = "."
 	}
 
+	return directory + "/testDataSetup/elasticsearch", nil
+}
+
+func createSearchIndex(url, instanceID, dimension string) error {
+	return createIndex(url, instanceID, dimension, "/mappings.json", "/typelessMappings.json")
+}
+
+// createRelevanceIndex creates a search index with the mappings of the search
+// relevance suite, which are kept apart from the mappings of the search tests
+func createRelevanceIndex(url, instanceID, dimension string) error {
+	return createIndex(url, instanceID, dimension, "/relevanceMappings.json", "/typelessRelevanceMappings.json")
+}
+
+func createIndex(url, instanceID, dimension, mappingsFile, typelessMappingsFile string) error {
+	directory, err := testDataDirectory()
+	if err != nil {
+		return err
+	}
+
 	index := elasticsearch.Index{
-		InstanceID:   instanceID,
-		Dimension:    dimension,
-		TestDataFile: directory + "/testDataSetup/elasticsearch/testData.json",
-		URL:          url,
-		MappingsFile: directory + "/testDataSetup/elasticsearch/mappings.json",
+		InstanceID:           instanceID,
+		Dimension:            dimension,
+		TestDataFile:         directory + "/testData.json",
+		URL:                  url,
+		MappingsFile:         directory + mappingsFile,
+		TypelessMappingsFile: directory + typelessMappingsFile,
 	}
 
 	if err := index.CreateSearchIndex(); err != nil {
@@ -0,0 +1,98 @@
+package recipeAPI
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetRecipe_ReturnsRecipe(t *testing.T) {
+
+	recipeAPI := httpexpect.New(t, cfg.RecipeAPIURL)
+
+	Convey("Given a recipe exists", t, func() {
+		Convey("When a request to get the recipe is made", func() {
+			Convey("Then the recipe is returned with a status of OK (200)", func() {
+
+				response := recipeAPI.GET("/recipes/{id}", cpihRecipeID).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				response.Value("id").Equal(cpihRecipeID)
+				response.Value("format").Equal("v4")
+				checkRecipe(response)
+
+				outputs := response.Value("output_instances").Array()
+				outputs.Length().Equal(1)
+
+				output := outputs.Element(0).Object()
+				output.Value("dataset_id").Equal(cpihRecipeDatasetID)
+				output.Value("code_lists").Array().Length().Equal(cpihRecipeCodeLists)
+			})
+		})
+	})
+
+	Convey("Given each recipe in the list of recipes", t, func() {
+		ids := recipeAPI.GET("/recipes").
+			Expect().Status(http.StatusOK).JSON().Path("$.items[*].id").Array()
+
+		Convey("When a request to get each recipe is made", func() {
+			Convey("Then the recipe returned matches the recipe in the list", func() {
+
+				for _, id := range ids.Iter() {
+					recipeID := id.String().Raw()
+
+					recipeAPI.GET("/recipes/{id}", recipeID).
+						Expect().Status(http.StatusOK).JSON().Object().
+						Value("id").Equal(recipeID)
+				}
+			})
+		})
+	})
+}
+
+func TestGetRecipe_RecipeDoesNotExist(t *testing.T) {
+
+	recipeAPI := httpexpect.New(t, cfg.RecipeAPIURL)
+
+	Convey("Given a recipe does not exist", t, func() {
+		Convey("When a request to get the recipe is made", func() {
+			Convey("Then the response returns status not found (404)", func() {
+
+				recipeAPI.GET("/recipes/{id}", invalidRecipeID).
+					Expect().Status(http.StatusNotFound)
+			})
+		})
+	})
+}
+
+// checkRecipe checks a recipe has the fields the import API relies on, with at
+// least one output instance and every code list linking to the code list API
+func checkRecipe(recipe *httpexpect.Object) {
+	recipe.Value("id").String().NotEmpty()
+	recipe.Value("alias").String().NotEmpty()
+	recipe.Value("format").String().NotEmpty()
+	recipe.Value("files").Array().NotEmpty()
+
+	outputs := recipe.Value("output_instances").Array()
+	outputs.NotEmpty()
+
+	for _, o := range outputs.Iter() {
+		output := o.Object()
+		output.Value("dataset_id").String().NotEmpty()
+		output.Value("editions").Array().NotEmpty()
+		output.Value("title").String().NotEmpty()
+
+		codeLists := output.Value("code_lists").Array()
+		codeLists.NotEmpty()
+
+		for _, c := range codeLists.Iter() {
+			codeList := c.Object()
+			id := codeList.Value("id").String().NotEmpty().Raw()
+			codeList.Value("href").String().Match("(.+)/code-lists/" + id + "$")
+			codeList.Value("name").String().NotEmpty()
+			codeList.Value("is_hierarchy").Boolean()
+		}
+	}
+}
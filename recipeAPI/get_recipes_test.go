@@ -0,0 +1,35 @@
+package recipeAPI
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gavv/httpexpect"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetRecipes_ReturnsAllRecipes(t *testing.T) {
+
+	recipeAPI := httpexpect.New(t, cfg.RecipeAPIURL)
+
+	Convey("Given the recipe API is running", t, func() {
+		Convey("When a request to get a list of recipes is made", func() {
+			Convey("Then a list of recipes is returned with a status of OK (200)", func() {
+
+				response := recipeAPI.GET("/recipes").
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				items := response.Value("items").Array()
+				response.Value("count").Equal(len(items.Iter()))
+				response.Value("total_count").Number().Ge(len(items.Iter()))
+
+				ids := response.Path("$.items[*].id").Array()
+				ids.Contains(cpihRecipeID, ociGrowthRecipeID)
+
+				for _, item := range items.Iter() {
+					checkRecipe(item.Object())
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,33 @@
+package recipeAPI
+
+import (
+	"os"
+
+	"github.com/ONSdigital/dp-api-tests/config"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+var cfg *config.Config
+
+const (
+	// cpihRecipeID is the recipe the end to end test imports with
+	cpihRecipeID        = "2943f3c5-c3f1-4a9a-aa6e-14d21c33524c"
+	cpihRecipeDatasetID = "cpih01"
+	cpihRecipeCodeLists = 3
+
+	// ociGrowthRecipeID is the recipe the import API tests create jobs with
+	ociGrowthRecipeID = "b944be78-f56d-409b-9ebd-ab2b77ffe187"
+
+	invalidRecipeID = "1C3221283FD544F0BBAD619779D8960E"
+)
+
+func init() {
+	var err error
+	cfg, err = config.Get()
+	if err != nil {
+		log.ErrorC("Unable to access configurations", err, nil)
+		os.Exit(1)
+	}
+
+	log.Debug("config is:", log.Data{"config": cfg})
+}
@@ -0,0 +1,40 @@
+package recipeAPIModels
+
+// RecipeList represents the list of recipes returned from the recipe API
+type RecipeList struct {
+	Count        int      `json:"count"`
+	Start        int      `json:"start"`
+	ItemsPerPage int      `json:"items_per_page"`
+	Items        []Recipe `json:"items"`
+	TotalCount   int      `json:"total_count"`
+}
+
+// Recipe represents how a source file is transformed into one or more instances
+type Recipe struct {
+	ID              string     `json:"id"`
+	Alias           string     `json:"alias"`
+	Format          string     `json:"format"`
+	InputFiles      []File     `json:"files"`
+	OutputInstances []Instance `json:"output_instances"`
+}
+
+// File represents a file the recipe expects as input
+type File struct {
+	Description string `json:"description"`
+}
+
+// Instance represents an instance of a dataset output by a recipe
+type Instance struct {
+	DatasetID string     `json:"dataset_id"`
+	Editions  []string   `json:"editions"`
+	Title     string     `json:"title"`
+	CodeLists []CodeList `json:"code_lists"`
+}
+
+// CodeList represents a code list used by a dimension of an output instance
+type CodeList struct {
+	ID          string `json:"id"`
+	HRef        string `json:"href"`
+	Name        string `json:"name"`
+	IsHierarchy bool   `json:"is_hierarchy"`
+}
@@ -0,0 +1,226 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// documentType is the mapping type used by clusters that still support them
+const documentType = "dimension_option"
+
+// bulkBatchSize is the number of documents sent in a single bulk request
+const bulkBatchSize = 500
+
+// maxSearchSize is the most documents elasticsearch returns from a search
+// without scrolling
+const maxSearchSize = 10000
+
+// typelessMajorVersion is the first major version of elasticsearch where
+// mapping types are deprecated
+const typelessMajorVersion = 7
+
+// ErrBulkFailed is returned when one or more documents in a bulk request fail
+var ErrBulkFailed = errors.New("elasticsearch bulk request contained errors")
+
+// Version represents the version of an elasticsearch cluster
+type Version struct {
+	Number string
+	Major  int
+}
+
+// Typeless returns true if the cluster no longer supports mapping types in
+// its endpoints or mappings
+func (v *Version) Typeless() bool {
+	return v.Major >= typelessMajorVersion
+}
+
+// IndexStats represents the document counts of an index
+type IndexStats struct {
+	Documents int64
+	Deleted   int64
+}
+
+type clusterInfo struct {
+	Version struct {
+		Number string `json:"number"`
+	} `json:"version"`
+}
+
+type bulkAction struct {
+	Index bulkActionMetadata `json:"index"`
+}
+
+type bulkActionMetadata struct {
+	ID string `json:"_id"`
+}
+
+type bulkResponse struct {
+	Errors bool                                `json:"errors"`
+	Items  []map[string]bulkResponseItemResult `json:"items"`
+}
+
+type bulkResponseItemResult struct {
+	ID     string          `json:"_id"`
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+type searchResponse struct {
+	Hits struct {
+		Hits []struct {
+			Source *Dimension `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+type indexStatsResponse struct {
+	All struct {
+		Primaries struct {
+			Docs struct {
+				Count   int64 `json:"count"`
+				Deleted int64 `json:"deleted"`
+			} `json:"docs"`
+		} `json:"primaries"`
+	} `json:"_all"`
+}
+
+// GetVersion returns the version of the elasticsearch cluster at the url
+func GetVersion(ctx context.Context, url string) (*Version, error) {
+	body, _, err := CallElastic(ctx, url, "GET", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var info clusterInfo
+	if err = json.Unmarshal(body, &info); err != nil {
+		log.ErrorC("unable to unmarshal elasticsearch cluster info", err, log.Data{"url": url})
+		return nil, err
+	}
+
+	major, err := strconv.Atoi(strings.Split(info.Version.Number, ".")[0])
+	if err != nil {
+		log.ErrorC("unable to parse elasticsearch version", err, log.Data{"url": url, "version": info.Version.Number})
+		return nil, err
+	}
+
+	return &Version{Number: info.Version.Number, Major: major}, nil
+}
+
+// BulkIndex adds dimension documents to an index in batches using the bulk
+// api, documents are identified by their code
+func BulkIndex(ctx context.Context, index string, version *Version, dimensions []*Dimension) error {
+	path := index + "/" + documentType + "/_bulk"
+	if version.Typeless() {
+		path = index + "/_bulk"
+	}
+
+	for i := 0; i < len(dimensions); i += bulkBatchSize {
+		end := i + bulkBatchSize
+		if end > len(dimensions) {
+			end = len(dimensions)
+		}
+
+		payload := new(bytes.Buffer)
+		encoder := json.NewEncoder(payload)
+		for _, dimension := range dimensions[i:end] {
+			if err := encoder.Encode(bulkAction{Index: bulkActionMetadata{ID: dimension.Code}}); err != nil {
+				return err
+			}
+			if err := encoder.Encode(dimension); err != nil {
+				return err
+			}
+		}
+
+		body, _, err := CallElastic(ctx, path, "POST", payload.Bytes())
+		if err != nil {
+			log.ErrorC("encountered error sending bulk request to elasticsearch", err, log.Data{"path": path})
+			return err
+		}
+
+		if err = checkBulkResponse(body); err != nil {
+			log.ErrorC("encountered error writing documents to elasticsearch index", err, log.Data{"path": path})
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkBulkResponse(body []byte) error {
+	var response bulkResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+
+	if !response.Errors {
+		return nil
+	}
+
+	for _, item := range response.Items {
+		for action, result := range item {
+			if len(result.Error) > 0 {
+				return fmt.Errorf("%v: %s of %s failed with status %d: %s", ErrBulkFailed, action, result.ID, result.Status, result.Error)
+			}
+		}
+	}
+
+	return ErrBulkFailed
+}
+
+// RefreshIndex makes all documents written to an index available to search
+func RefreshIndex(ctx context.Context, index string) error {
+	if _, _, err := CallElastic(ctx, index+"/_refresh", "POST", nil); err != nil {
+		log.ErrorC("failed to refresh elasticsearch index", err, log.Data{"path": index})
+		return err
+	}
+	return nil
+}
+
+// GetIndexStats returns the number of documents in the primary shards of an index
+func GetIndexStats(ctx context.Context, index string) (*IndexStats, error) {
+	body, _, err := CallElastic(ctx, index+"/_stats/docs", "GET", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response indexStatsResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		log.ErrorC("unable to unmarshal elasticsearch index stats", err, log.Data{"path": index})
+		return nil, err
+	}
+
+	return &IndexStats{
+		Documents: response.All.Primaries.Docs.Count,
+		Deleted:   response.All.Primaries.Docs.Deleted,
+	}, nil
+}
+
+// GetDimensions returns the dimension documents of an index, which must hold
+// no more than maxSearchSize documents
+func GetDimensions(ctx context.Context, index string) ([]*Dimension, error) {
+	path := index + "/_search?size=" + strconv.Itoa(maxSearchSize)
+	body, _, err := CallElastic(ctx, path, "GET", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response searchResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		log.ErrorC("unable to unmarshal elasticsearch search response", err, log.Data{"path": path})
+		return nil, err
+	}
+
+	var dimensions []*Dimension
+	for _, hit := range response.Hits.Hits {
+		dimensions = append(dimensions, hit.Source)
+	}
+
+	return dimensions, nil
+}
@@ -15,12 +15,16 @@ import (
 	"github.com/ONSdigital/go-ns/rchttp"
 )
 
+// Index represents a search index and the test data to load into it.
+// MappingsFile contains mappings with a dimension_option type and is used for
+// clusters before 7.x, TypelessMappingsFile is used for later clusters.
 type Index struct {
-	InstanceID   string
-	Dimension    string
-	TestDataFile string
-	URL          string
-	MappingsFile string
+	InstanceID           string
+	Dimension            string
+	TestDataFile         string
+	URL                  string
+	MappingsFile         string
+	TypelessMappingsFile string
 }
 
 var client = rchttp.NewClient()
@@ -75,9 +79,17 @@ type Dimension struct {
 	URL              string `json:"url"`
 }
 
-// CreateSearchIndex represents the creation and loading of test data into an index for testing
+// Path returns the url of the index
+func (i *Index) Path() string {
+	return i.URL + "/" + i.InstanceID + "_" + i.Dimension
+}
+
+// CreateSearchIndex represents the creation and loading of test data into an
+// index for testing. Mappings and endpoints are chosen to match the version
+// of the cluster, and the index is refreshed before returning so all test
+// data can be searched immediately.
 func (i *Index) CreateSearchIndex() error {
-	index := i.URL + "/" + i.InstanceID + "_" + i.Dimension
+	index := i.Path()
 	// Remove index
 	statusCode, err := DeleteIndex(index)
 	if err != nil {
@@ -89,10 +101,27 @@ func (i *Index) CreateSearchIndex() error {
 
 	ctx := context.Background()
 
+	version, err := GetVersion(ctx, i.URL)
+	if err != nil {
+		log.ErrorC("failed to get elasticsearch version", err, log.Data{"url": i.URL})
+		return err
+	}
+
+	mappingsFile := i.MappingsFile
+	if version.Typeless() {
+		mappingsFile = i.TypelessMappingsFile
+	}
+
+	if mappingsFile == "" {
+		err = errors.New("no mappings file for elasticsearch version")
+		log.ErrorC("failed to create index", err, log.Data{"path": index, "version": version.Number})
+		return err
+	}
+
 	// Create index
-	indexMappings, err := ioutil.ReadFile(i.MappingsFile)
+	indexMappings, err := ioutil.ReadFile(mappingsFile)
 	if err != nil {
-		log.ErrorC("failed to read elasticsearch mappings file", err, log.Data{"elasticsearch_mappings_file": i.MappingsFile})
+		log.ErrorC("failed to read elasticsearch mappings file", err, log.Data{"elasticsearch_mappings_file": mappingsFile})
 		return err
 	}
 
@@ -102,44 +131,74 @@ func (i *Index) CreateSearchIndex() error {
 		return err
 	}
 
-	logData := log.Data{"json_file": i.TestDataFile}
+	dimensions, err := readDimensions(i.TestDataFile)
+	if err != nil {
+		return err
+	}
 
 	// Add docs to index
-	file, err := os.Open(i.TestDataFile)
+	if err = BulkIndex(ctx, index, version, dimensions); err != nil {
+		log.ErrorC("encountered error writing to elasticsearch index", err, log.Data{"json_file": i.TestDataFile})
+		return err
+	}
+
+	if err = RefreshIndex(ctx, index); err != nil {
+		return err
+	}
+
+	stats, err := GetIndexStats(ctx, index)
 	if err != nil {
-		log.ErrorC("fail to open file containing elasticsearch test data", err, logData)
 		return err
 	}
+
+	if stats.Documents != int64(len(dimensions)) {
+		err = errors.New("unexpected number of documents in elasticsearch index")
+		log.ErrorC("failed to load all test data into index", err, log.Data{"path": index, "expected": len(dimensions), "documents": stats.Documents})
+		return err
+	}
+
+	log.Info("successfully loaded data into elasticsearch", log.Data{"json_file": i.TestDataFile, "documents": len(dimensions), "version": version.Number})
+	return nil
+}
+
+// Stats returns the document counts of the index
+func (i *Index) Stats() (*IndexStats, error) {
+	return GetIndexStats(context.Background(), i.Path())
+}
+
+// readDimensions reads a file containing one dimension document per line
+func readDimensions(filename string) ([]*Dimension, error) {
+	logData := log.Data{"json_file": filename}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		log.ErrorC("fail to open file containing elasticsearch test data", err, logData)
+		return nil, err
+	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			log.ErrorC("CreateSearchIndex", err, logData)
+			log.ErrorC("readDimensions", err, logData)
 		}
 	}()
 
+	var dimensions []*Dimension
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
 		line := scanner.Text()
-		slice := []byte(line)
 		var dimension *Dimension
-		if err = json.Unmarshal(slice, &dimension); err != nil {
+		if err = json.Unmarshal([]byte(line), &dimension); err != nil {
 			log.ErrorC("unable to unmarshal bytes to json", err, log.Data{"line": line})
-			return err
-		}
-		path := index + "/dimension_option/" + dimension.Code
-		_, _, err = CallElastic(ctx, path, "PUT", slice)
-		if err != nil {
-			log.ErrorC("encountered error writing to elasticsearch index", err, log.Data{"json_file": i.TestDataFile, "payload": scanner.Text()})
-			return err
+			return nil, err
 		}
+		dimensions = append(dimensions, dimension)
 	}
 
 	if err := scanner.Err(); err != nil {
-		log.ErrorC("encountered problem scanning file", err, log.Data{"json_file": i.TestDataFile})
-		return err
+		log.ErrorC("encountered problem scanning file", err, logData)
+		return nil, err
 	}
 
-	log.Info("successfully loaded data into elasticsearch", log.Data{"json_file": i.TestDataFile})
-	return nil
+	return dimensions, nil
 }
 
 // CallElastic builds a request to elastic search based on the method, path and payload
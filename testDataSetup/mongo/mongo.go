@@ -1,12 +1,12 @@
 package mongo
 
 import (
-	//"github.com/ONSdigital/dp-api-tests/identityAPIModels"
 	"time"
 
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 
+	"github.com/ONSdigital/dp-api-tests/identityAPIModels"
 	datasetAPIModel "github.com/ONSdigital/dp-dataset-api/models"
 	importAPIModel "github.com/ONSdigital/dp-import-api/models"
 	"github.com/ONSdigital/go-ns/log"
@@ -439,6 +439,19 @@ func GetDimensionOption(database, collection, key, value string) (dimensionOptio
 	return
 }
 
+// GetDimensionOptions retrieves all dimension option documents matching the key and value from mongo
+func GetDimensionOptions(database, collection, key, value string) ([]datasetAPIModel.DimensionOption, error) {
+	s := session.Copy()
+	defer s.Close()
+
+	var dimensionOptions []datasetAPIModel.DimensionOption
+	if err := s.DB(database).C(collection).Find(bson.M{key: value}).All(&dimensionOptions); err != nil {
+		return nil, err
+	}
+
+	return dimensionOptions, nil
+}
+
 // CountDimensionOptions retrieves a count of the number of dimension options exist for an instance in mongo
 func CountDimensionOptions(database, collection, key, value string) (int, error) {
 	s := session.Copy()
@@ -447,7 +460,7 @@ func CountDimensionOptions(database, collection, key, value string) (int, error)
 	return s.DB(database).C(collection).Find(bson.M{key: value}).Count()
 }
 
-/*
+// GetIdentity retrieves an identity document from mongo
 func GetIdentity(database, collection, key, value string) (*identityAPIModels.Mongo, error) {
 	s := session.Copy()
 	defer s.Close()
@@ -460,17 +473,13 @@ func GetIdentity(database, collection, key, value string) (*identityAPIModels.Mo
 	return &i, nil
 }
 
-func GetIdentities(database, collection string) ([]identityAPIModels.Mongo, error) {
+// CountIdentities retrieves a count of the number of identity documents in mongo matching the key and value
+func CountIdentities(database, collection, key, value string) (int, error) {
 	s := session.Copy()
 	defer s.Close()
 
-	var results []identityAPIModels.Mongo
-	if err := s.DB(database).C(collection).Find(nil).All(&results); err != nil {
-		return nil, err
-	}
-	return results, nil
+	return s.DB(database).C(collection).Find(bson.M{key: value}).Count()
 }
-*/
 
 // Possible values for flagging whether a filter resource (output or blueprint)
 // is a filter against a published or unpublished version
@@ -0,0 +1,146 @@
+package mongo
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// defaultWaitInterval is how often a waiter polls, or stops waiting on a
+// change stream to check the deadline, if no interval is given
+const defaultWaitInterval = 200 * time.Millisecond
+
+// bsonDocument is the kind of a bson value holding an embedded document
+const bsonDocument = 0x03
+
+// ErrWaitTimedOut is returned when a document is not ready before the deadline
+var ErrWaitTimedOut = errors.New("timed out waiting for mongo document")
+
+// Waiter waits for the document with the given key and value to be ready.
+// With Watch set, updates to the document are received from a change stream
+// on the collection rather than by polling every Interval. Change streams need
+// the server to be a replica set, so the waiter falls back to polling if one
+// cannot be opened.
+type Waiter struct {
+	Database   string
+	Collection string
+	Key        string
+	Value      string
+	Watch      bool
+	Interval   time.Duration
+	Deadline   time.Time
+}
+
+// Until decodes the document into result, calling ready each time the document
+// changes until it returns true or the deadline passes. A document that does
+// not exist yet is not ready.
+func (w *Waiter) Until(result interface{}, ready func() bool) error {
+	s := session.Copy()
+	defer s.Close()
+
+	collection := s.DB(w.Database).C(w.Collection)
+
+	if w.Watch {
+		// the stream is opened before the document is first read, so no update
+		// between reading it and watching it is missed
+		pipeline := []bson.M{{"$match": bson.M{"fullDocument." + w.Key: w.Value}}}
+		stream, err := collection.Watch(pipeline, mgo.ChangeStreamOptions{FullDocument: mgo.UpdateLookup, MaxAwaitTimeMS: w.interval()})
+		if err == nil {
+			defer func() {
+				if err := stream.Close(); err != nil {
+					log.ErrorC("failed to close change stream", err, w.logData())
+				}
+			}()
+			return w.watch(collection, stream, result, ready)
+		}
+
+		logData := w.logData()
+		logData["error"] = err.Error()
+		log.Info("unable to watch collection, polling instead", logData)
+	}
+
+	return w.poll(collection, result, ready)
+}
+
+func (w *Waiter) watch(collection *mgo.Collection, stream *mgo.ChangeStream, result interface{}, ready func() bool) error {
+	if ok, err := w.check(collection, result, ready); err != nil || ok {
+		return err
+	}
+
+	var change struct {
+		FullDocument bson.Raw `bson:"fullDocument"`
+	}
+
+	for {
+		if stream.Next(&change) {
+			// a deleted document has no full document to check
+			if change.FullDocument.Kind == bsonDocument {
+				reset(result)
+				if err := change.FullDocument.Unmarshal(result); err != nil {
+					return err
+				}
+
+				if ready() {
+					return nil
+				}
+			}
+		} else if err := stream.Err(); err != nil {
+			log.ErrorC("change stream failed, polling instead", err, w.logData())
+			return w.poll(collection, result, ready)
+		}
+
+		if time.Now().After(w.Deadline) {
+			return ErrWaitTimedOut
+		}
+	}
+}
+
+func (w *Waiter) poll(collection *mgo.Collection, result interface{}, ready func() bool) error {
+	for {
+		if ok, err := w.check(collection, result, ready); err != nil || ok {
+			return err
+		}
+
+		if time.Now().After(w.Deadline) {
+			return ErrWaitTimedOut
+		}
+
+		time.Sleep(w.interval())
+	}
+}
+
+// check reads the document into result and returns whether it is ready
+func (w *Waiter) check(collection *mgo.Collection, result interface{}, ready func() bool) (bool, error) {
+	reset(result)
+	if err := collection.Find(bson.M{w.Key: w.Value}).One(result); err != nil {
+		if err == mgo.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return ready(), nil
+}
+
+func (w *Waiter) interval() time.Duration {
+	if w.Interval > 0 {
+		return w.Interval
+	}
+	return defaultWaitInterval
+}
+
+func (w *Waiter) logData() log.Data {
+	return log.Data{"database": w.Database, "collection": w.Collection, w.Key: w.Value}
+}
+
+// reset zeroes the value result points to, so fields missing from the next
+// document read into it are not left over from the last
+func reset(result interface{}) {
+	v := reflect.ValueOf(result).Elem()
+	v.Set(reflect.Zero(v.Type()))
+}
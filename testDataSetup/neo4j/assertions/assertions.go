@@ -0,0 +1,71 @@
+// Package assertions wraps the neo4j query helpers in goconvey assertions, so
+// they must be called from within a Convey block
+package assertions
+
+import (
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// InstanceProperties asserts the instance node exists and returns its properties
+func InstanceProperties(ds *neo4j.Datastore, instanceID string) map[string]interface{} {
+	props, err := ds.GetInstanceProperties(instanceID)
+	So(err, ShouldBeNil)
+	return props
+}
+
+// ShouldHaveInstanceProperty asserts the instance node has a property with the expected value
+func ShouldHaveInstanceProperty(ds *neo4j.Datastore, instanceID, property string, expected interface{}) {
+	props := InstanceProperties(ds, instanceID)
+	So(props, ShouldContainKey, property)
+	So(props[property], ShouldEqual, expected)
+}
+
+// ShouldHaveObservations asserts the number of observation nodes for an instance
+func ShouldHaveObservations(ds *neo4j.Datastore, instanceID string, expected int64) {
+	count, err := ds.CountObservations(instanceID)
+	So(err, ShouldBeNil)
+	So(count, ShouldEqual, expected)
+}
+
+// DimensionOptions asserts the options of an instance can be read and returns them
+func DimensionOptions(ds *neo4j.Datastore, instanceID string) map[string][]string {
+	options, err := ds.GetDimensionOptions(instanceID)
+	So(err, ShouldBeNil)
+	return options
+}
+
+// ShouldHaveDimensionOptionCounts asserts the number of options for each
+// dimension of an instance, keyed by dimension name
+func ShouldHaveDimensionOptionCounts(ds *neo4j.Datastore, instanceID string, expected map[string]int) {
+	options := DimensionOptions(ds, instanceID)
+	So(options, ShouldHaveLength, len(expected))
+	for dimension, count := range expected {
+		So(options, ShouldContainKey, dimension)
+		So(options[dimension], ShouldHaveLength, count)
+	}
+}
+
+// ShouldHaveDimensionOption asserts an option exists for a dimension of an instance
+func ShouldHaveDimensionOption(ds *neo4j.Datastore, instanceID, dimension, option string) {
+	options := DimensionOptions(ds, instanceID)
+	So(options[dimension], ShouldContain, option)
+}
+
+// HierarchySubtree asserts the hierarchy of an instance dimension exists from
+// the given code, or from the root if no code is given, and returns it
+func HierarchySubtree(ds *neo4j.Datastore, instanceID, dimension, code string) *neo4j.HierarchyTreeNode {
+	node, err := ds.GetHierarchySubtree(instanceID, dimension, code)
+	So(err, ShouldBeNil)
+	So(node, ShouldNotBeNil)
+	return node
+}
+
+// ShouldHaveHierarchy asserts the size of a hierarchy and the number of
+// children of its root node
+func ShouldHaveHierarchy(ds *neo4j.Datastore, instanceID, dimension string, size, rootChildren int) {
+	root := HierarchySubtree(ds, instanceID, dimension, "")
+	So(root.Size(), ShouldEqual, size)
+	So(root.Children, ShouldHaveLength, rootChildren)
+	So(root.NumberOfChildren, ShouldEqual, rootChildren)
+}
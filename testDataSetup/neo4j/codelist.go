@@ -0,0 +1,133 @@
+package neo4j
+
+import (
+	"fmt"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+)
+
+// Code represents a single code and the label it has within an edition of a code list
+type Code struct {
+	Value string
+	Label string
+}
+
+// CodeListEdition represents an edition of a code list and the codes it uses
+type CodeListEdition struct {
+	Edition string
+	Label   string
+	Codes   []*Code
+}
+
+// CodeList represents a code list with one or more editions. Type is the
+// type of dimension the code list is used for, such as geography or time.
+type CodeList struct {
+	ID       string
+	Type     string
+	Editions []*CodeListEdition
+}
+
+// CodeListDataset represents a version of a dataset which uses codes from an
+// edition of a code list for one of its dimensions
+type CodeListDataset struct {
+	InstanceID string
+	DatasetID  string
+	Edition    string
+	Version    int
+	Published  bool
+
+	CodeListID      string
+	CodeListEdition string
+	Codes           []string
+}
+
+// NewCodeListFixture builds the nodes and relationships of code lists and the
+// datasets using their codes. Each edition of a code list is a separate node
+// and a code used by more than one edition is a single node related to each.
+func NewCodeListFixture(codeLists []*CodeList, datasets ...*CodeListDataset) (*Fixture, error) {
+	f := &Fixture{}
+
+	// code refs keyed by code list id and then value, and edition refs keyed
+	// by code list id and then edition
+	codes := make(map[string]map[string]string)
+	editions := make(map[string]map[string]string)
+
+	for _, cl := range codeLists {
+		codes[cl.ID] = make(map[string]string)
+		editions[cl.ID] = make(map[string]string)
+
+		for _, e := range cl.Editions {
+			if _, ok := editions[cl.ID][e.Edition]; ok {
+				return nil, fmt.Errorf("code list %s has more than one %s edition", cl.ID, e.Edition)
+			}
+
+			properties := map[string]interface{}{
+				"label":   e.Label,
+				"edition": e.Edition,
+			}
+			if cl.Type != "" {
+				properties["type"] = cl.Type
+			}
+
+			edition := f.addNode([]string{"_code_list", "_code_list_" + cl.ID}, properties)
+			editions[cl.ID][e.Edition] = edition
+
+			for _, c := range e.Codes {
+				code, ok := codes[cl.ID][c.Value]
+				if !ok {
+					code = f.addNode([]string{"_code", "_code_" + cl.ID}, map[string]interface{}{
+						"value": c.Value,
+					})
+					codes[cl.ID][c.Value] = code
+				}
+
+				f.addRelationshipWithProperties(code, edition, "usedBy", map[string]interface{}{"label": c.Label})
+			}
+		}
+	}
+
+	for _, d := range datasets {
+		if _, ok := editions[d.CodeListID][d.CodeListEdition]; !ok {
+			return nil, fmt.Errorf("dataset %s uses unknown code list edition %s/%s", d.DatasetID, d.CodeListID, d.CodeListEdition)
+		}
+
+		instance := f.addNode([]string{fmt.Sprintf("_%s_Instance", d.InstanceID)}, map[string]interface{}{
+			"dataset_id":   d.DatasetID,
+			"edition":      d.Edition,
+			"version":      d.Version,
+			"is_published": d.Published,
+		})
+
+		for _, value := range d.Codes {
+			code, ok := codes[d.CodeListID][value]
+			if !ok {
+				return nil, fmt.Errorf("dataset %s uses unknown code %s", d.DatasetID, value)
+			}
+			f.addRelationship(code, instance, "inDataset")
+		}
+	}
+
+	return f, nil
+}
+
+// NewV4CodeLists returns a code list for each dimension of a V4 file, with a
+// single edition holding every code the dimension uses. A code used with more
+// than one label, as time codes are, keeps the first label in the file.
+func NewV4CodeLists(file *v4.File, edition string) []*CodeList {
+	var codeLists []*CodeList
+	for _, d := range file.Dimensions {
+		e := &CodeListEdition{Edition: edition, Label: d.Name}
+
+		seen := make(map[string]bool)
+		for _, o := range d.Options {
+			if !seen[o.Code] {
+				seen[o.Code] = true
+				e.Codes = append(e.Codes, &Code{Value: o.Code, Label: o.Label})
+			}
+		}
+
+		codeLists = append(codeLists, &CodeList{ID: d.CodeListID, Editions: []*CodeListEdition{e}})
+	}
+
+	return codeLists
+}
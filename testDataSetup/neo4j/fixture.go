@@ -0,0 +1,389 @@
+package neo4j
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// V4TestFile is the V4 file imported by the end to end tests
+const V4TestFile = "../../endToEndTests/v4TestFile.csv"
+
+// CPIHHierarchyDefinition is the generic hierarchy of the CPIH code list
+const CPIHHierarchyDefinition = "../../testDataSetup/neo4j/hierarchyCPIH.csv"
+
+// CPIHCodeListID is the code list of the aggregate dimension in the V4 test file
+const CPIHCodeListID = "cpih1dim1aggid"
+
+// CPITestFile is a V4 file of a single month of CPI, used by the suites
+// checking observations, filter previews and hierarchies of an instance
+const CPITestFile = "../../testDataSetup/neo4j/v4CPITestFile.csv"
+
+// CPIHierarchyDefinition is the generic hierarchy of the CPI code list
+const CPIHierarchyDefinition = "../../testDataSetup/neo4j/hierarchyCPI.csv"
+
+// CPICodeListID is the code list of the aggregate dimension in the CPI test file
+const CPICodeListID = "e44de4c4-d39e-4e2f-942b-3ca10584d078"
+
+const timeDimension = "time"
+
+// GraphNode represents a single node to be created in neo4j, the ref is only
+// used to link relationships to nodes and is never stored
+type GraphNode struct {
+	Ref        string
+	Labels     []string
+	Properties map[string]interface{}
+}
+
+// GraphRelationship represents a directed relationship between two nodes
+type GraphRelationship struct {
+	From       string
+	To         string
+	Type       string
+	Properties map[string]interface{}
+}
+
+// Fixture represents the nodes and relationships the import pipeline
+// creates in neo4j for a single instance. A fixture built while being loaded
+// passes each node and relationship to its writer rather than holding them.
+type Fixture struct {
+	InstanceID    string
+	Nodes         []*GraphNode
+	Relationships []*GraphRelationship
+
+	refs   int
+	writer fixtureWriter
+	err    error
+}
+
+// fixtureWriter receives the nodes and relationships of a fixture as they are
+// built. Relationships only reference nodes already written.
+type fixtureWriter interface {
+	writeNode(n *GraphNode) error
+	writeRelationship(r *GraphRelationship) error
+}
+
+// HierarchyNode represents a single code within a generic hierarchy
+type HierarchyNode struct {
+	Code   string
+	Label  string
+	Parent string
+}
+
+// HierarchyDefinition represents the generic hierarchy for a code list
+type HierarchyDefinition struct {
+	CodeListID string
+	Nodes      []*HierarchyNode
+}
+
+// ReadHierarchyDefinition reads a generic hierarchy for a code list from a csv
+// file with the columns code, label and parent_code
+func ReadHierarchyDefinition(codeListID, filename string) (*HierarchyDefinition, error) {
+	definition := &HierarchyDefinition{CodeListID: codeListID}
+	err := readHierarchyNodes(filename, func(n *HierarchyNode) error {
+		definition.Nodes = append(definition.Nodes, n)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+// readHierarchyNodes passes each node of a hierarchy definition file to fn as
+// it is read
+func readHierarchyNodes(filename string, fn func(n *HierarchyNode) error) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.ErrorC("readHierarchyNodes", err, log.Data{"file": filename})
+		}
+	}()
+
+	reader := csv.NewReader(file)
+	if _, err = reader.Read(); err != nil {
+		return err
+	}
+
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(line) != 3 {
+			return fmt.Errorf("hierarchy definition %s has %d columns, expected 3", filename, len(line))
+		}
+
+		if err = fn(&HierarchyNode{Code: line[0], Label: line[1], Parent: line[2]}); err != nil {
+			return err
+		}
+	}
+}
+
+// NewInstanceFixture builds the graph the import pipeline would create for an
+// instance from a V4 file, including a hierarchy for each dimension that uses
+// one of the code lists in the given hierarchy definitions
+func NewInstanceFixture(instanceID, v4File string, hierarchies ...*HierarchyDefinition) (*Fixture, error) {
+	f := &Fixture{InstanceID: instanceID}
+	if err := f.addInstance(v4File, hierarchies...); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// BuildCPIHInstance builds the graph of an instance imported from the V4 test
+// file, with the CPIH hierarchy for its aggregate dimension
+func BuildCPIHInstance(f *Fixture) error {
+	h, err := ReadHierarchyDefinition(CPIHCodeListID, CPIHHierarchyDefinition)
+	if err != nil {
+		return err
+	}
+
+	return f.addInstance(V4TestFile, h)
+}
+
+// BuildCPIInstance builds the graph of an instance imported from the CPI test
+// file, with the CPI hierarchy for its aggregate dimension
+func BuildCPIInstance(f *Fixture) error {
+	h, err := ReadHierarchyDefinition(CPICodeListID, CPIHierarchyDefinition)
+	if err != nil {
+		return err
+	}
+
+	return f.addInstance(CPITestFile, h)
+}
+
+// addInstance adds the graph of an instance to the fixture, row by row as the
+// V4 file is read
+func (f *Fixture) addInstance(v4File string, hierarchies ...*HierarchyDefinition) error {
+	instanceID := f.InstanceID
+
+	file, err := os.Open(v4File)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.ErrorC("addInstance", err, log.Data{"v4_file": v4File})
+		}
+	}()
+
+	reader := csv.NewReader(file)
+	headerRow, err := reader.Read()
+	if err != nil {
+		return err
+	}
+
+	header, err := v4.ParseHeader(headerRow)
+	if err != nil {
+		return err
+	}
+
+	// the dimension extractor stores dimension names in lower case, whatever
+	// the case of the header
+	var dimensionNames []string
+	for i, d := range header.Dimensions {
+		header.Dimensions[i].Name = strings.ToLower(d.Name)
+		dimensionNames = append(dimensionNames, header.Dimensions[i].Name)
+	}
+
+	instance := f.addNode([]string{fmt.Sprintf("_%s_Instance", instanceID)}, map[string]interface{}{
+		"dimensions": dimensionNames,
+		"header":     strings.Join(headerRow, ","),
+	})
+
+	// option refs keyed by dimension name and then option value
+	options := make(map[string]map[string]string)
+	for _, d := range header.Dimensions {
+		options[d.Name] = make(map[string]string)
+	}
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(row) != len(headerRow) {
+			return fmt.Errorf("observation row has %d columns, expected %d", len(row), len(headerRow))
+		}
+
+		observation := f.addNode([]string{fmt.Sprintf("_%s_observation", instanceID)}, map[string]interface{}{
+			"value": csvLine(row),
+		})
+
+		for _, d := range header.Dimensions {
+			value := optionValue(d.Name, row[d.Column], row[d.Column+1])
+
+			option, ok := options[d.Name][value]
+			if !ok {
+				option = f.addNode([]string{fmt.Sprintf("_%s_%s", instanceID, d.Name)}, map[string]interface{}{
+					"value": value,
+				})
+				options[d.Name][value] = option
+				f.addRelationship(instance, option, "HAS_DIMENSION")
+			}
+
+			f.addRelationship(observation, option, "isValueOf")
+		}
+
+		if f.err != nil {
+			return f.err
+		}
+	}
+
+	for _, d := range header.Dimensions {
+		for _, h := range hierarchies {
+			if h.CodeListID == d.CodeListID {
+				if err := f.addHierarchy(d.Name, h, options[d.Name]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return f.err
+}
+
+// addHierarchy clones a generic hierarchy for a dimension of the instance in
+// the same way as the hierarchy builder. Nodes are only kept if they, or one of
+// their descendants, are an option of the dimension, and a node whose parent is
+// not in the definition is an error.
+func (f *Fixture) addHierarchy(dimension string, h *HierarchyDefinition, options map[string]string) error {
+	codes := make(map[string]bool)
+	for _, n := range h.Nodes {
+		codes[n.Code] = true
+	}
+
+	children := make(map[string][]string)
+	for _, n := range h.Nodes {
+		if n.Parent == "" {
+			continue
+		}
+		if !codes[n.Parent] {
+			return fmt.Errorf("hierarchy for code list %s is missing parent %s of %s", h.CodeListID, n.Parent, n.Code)
+		}
+		children[n.Parent] = append(children[n.Parent], n.Code)
+	}
+
+	remain := make(map[string]bool)
+	var mark func(code string, depth int) (bool, error)
+	mark = func(code string, depth int) (bool, error) {
+		if depth > len(h.Nodes) {
+			return false, fmt.Errorf("hierarchy for code list %s contains a cycle at %s", h.CodeListID, code)
+		}
+		_, hasData := options[code]
+		for _, child := range children[code] {
+			childRemains, err := mark(child, depth+1)
+			if err != nil {
+				return false, err
+			}
+			hasData = hasData || childRemains
+		}
+		remain[code] = hasData
+		return hasData, nil
+	}
+
+	for _, n := range h.Nodes {
+		if n.Parent == "" {
+			if _, err := mark(n.Code, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	label := fmt.Sprintf("_hierarchy_node_%s_%s", f.InstanceID, dimension)
+	refs := make(map[string]string)
+	for _, n := range h.Nodes {
+		if !remain[n.Code] {
+			continue
+		}
+
+		numberOfChildren := 0
+		for _, child := range children[n.Code] {
+			if remain[child] {
+				numberOfChildren++
+			}
+		}
+
+		_, hasData := options[n.Code]
+		refs[n.Code] = f.addNode([]string{label}, map[string]interface{}{
+			"code":             n.Code,
+			"code_list":        h.CodeListID,
+			"hasData":          hasData,
+			"label":            n.Label,
+			"numberOfChildren": numberOfChildren,
+		})
+	}
+
+	for _, n := range h.Nodes {
+		if child, ok := refs[n.Code]; ok && n.Parent != "" {
+			f.addRelationship(child, refs[n.Parent], "hasParent")
+		}
+	}
+
+	return nil
+}
+
+// addNode adds a node to the fixture, or passes it to the writer of the
+// fixture, returning the ref relationships to it are made with. The first
+// error from the writer is kept and any later nodes are dropped.
+func (f *Fixture) addNode(labels []string, properties map[string]interface{}) string {
+	ref := fmt.Sprintf("_%d", f.refs)
+	f.refs++
+
+	n := &GraphNode{Ref: ref, Labels: labels, Properties: properties}
+	if f.writer == nil {
+		f.Nodes = append(f.Nodes, n)
+	} else if f.err == nil {
+		f.err = f.writer.writeNode(n)
+	}
+
+	return ref
+}
+
+func (f *Fixture) addRelationship(from, to, relationshipType string) {
+	f.addRelationshipWithProperties(from, to, relationshipType, nil)
+}
+
+func (f *Fixture) addRelationshipWithProperties(from, to, relationshipType string, properties map[string]interface{}) {
+	r := &GraphRelationship{From: from, To: to, Type: relationshipType, Properties: properties}
+	if f.writer == nil {
+		f.Relationships = append(f.Relationships, r)
+	} else if f.err == nil {
+		f.err = f.writer.writeRelationship(r)
+	}
+}
+
+// optionValue returns the value the dimension extractor stores for an option.
+// Time codes in a V4 file describe the period type (e.g. Month), so the label
+// is stored for the time dimension instead.
+func optionValue(dimension, code, label string) string {
+	if dimension == timeDimension {
+		return label
+	}
+	return code
+}
+
+func csvLine(row []string) string {
+	line := new(bytes.Buffer)
+	w := csv.NewWriter(line)
+	w.Write(row)
+	w.Flush()
+	return strings.TrimSuffix(line.String(), "\n")
+}
@@ -1,536 +0,0 @@
-
-create (_653:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1A0", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"CPI (overall index)", `numberOfChildren`:12})
-create (_654:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T60000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06 Health", `numberOfChildren`:3})
-create (_655:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G60200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.2 Out-patient services", `numberOfChildren`:2})
-create (_936:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S60202", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.2.2 Dental services", `numberOfChildren`:0})
-create (_937:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S60201", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.2.1/3 Medical services, paramedical services", `numberOfChildren`:0})
-create (_938:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G60100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.1 Medical products, appliances and equipment", `numberOfChildren`:2})
-create (_939:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1A0", `label`:"CPI (overall index)"})
-create (_940:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T60000", `label`:"06 Health"})
-create (_941:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G60200", `label`:"06.2 Out-patient services"})
-create (_942:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S60202", `label`:"06.2.2 Dental services"})
-create (_943:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S60201", `label`:"06.2.1/3 Medical services, paramedical services"})
-create (_944:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G60100", `label`:"06.1 Medical products, appliances and equipment"})
-create (_945:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S60102", `label`:"06.1.2/3 Other medical and therapeutic equipment"})
-create (_946:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S60101", `label`:"06.1.1 Pharmaceutical products"})
-create (_947:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G60300", `label`:"06.3 Hospital services"})
-create (_948:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S60300", `label`:"06.3.0 Hospital Services"})
-create (_949:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T10000", `label`:"01 Food and non-alcoholic beverages"})
-create (_950:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G10200", `label`:"01.2 Non-alcoholic beverages"})
-create (_951:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10201", `label`:"01.2.1 Coffee, tea and cocoa"})
-create (_952:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10202", `label`:"01.2.2 Mineral waters, soft drinks and juices"})
-create (_953:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G10100", `label`:"01.1 Food"})
-create (_954:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10103", `label`:"01.1.3 Fish"})
-create (_955:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10106", `label`:"01.1.6 Fruit"})
-create (_956:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10108", `label`:"01.1.8 Sugar, jam, syrups, chocolate and confectionery"})
-create (_957:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10107", `label`:"01.1.7 Vegetables including potatoes and tubers"})
-create (_958:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10109", `label`:"01.1.9 Food products (nec)"})
-create (_959:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10101", `label`:"01.1.1 Bread and cereals"})
-create (_960:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10104", `label`:"01.1.4 Milk, cheese and eggs"})
-create (_961:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10105", `label`:"01.1.5 Oils and fats"})
-create (_962:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S10102", `label`:"01.1.2 Meat"})
-create (_963:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T70000", `label`:"07 Transport"})
-create (_964:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G70100", `label`:"07.1 Purchase of vehicles"})
-create (_965:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70102", `label`:"07.1.2/3 Motorcycles and bicycles"})
-create (_966:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70191", `label`:"07.1.1b Second Hand Cars"})
-create (_967:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70181", `label`:"07.1.1 New Cars"})
-create (_968:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G70300", `label`:"07.3 Transport services"})
-create (_969:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70304", `label`:"07.3.4 Passenger transport by sea and inland waterway"})
-create (_970:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70302", `label`:"07.3.2 Passenger transport by road"})
-create (_971:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70303", `label`:"07.3.3 Passenger transport by air"})
-create (_972:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70301", `label`:"07.3.1 Passenger transport by railway"})
-create (_973:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G70200", `label`:"07.2 Operation of personal transport equipment"})
-create (_974:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70203", `label`:"07.2.3 Maintenance and repairs"})
-create (_975:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70202", `label`:"07.2.2 Fuels and lubricants"})
-create (_976:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70201", `label`:"07.2.1 Spare parts and accessories"})
-create (_977:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S70204", `label`:"07.2.4 Other services"})
-create (_978:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T30000", `label`:"03 Clothing and footwear"})
-create (_979:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G30100", `label`:"03.1 Clothing"})
-create (_980:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S30103", `label`:"03.1.3 Other clothing and clothing accessories"})
-create (_981:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S30104", `label`:"03.1.4 Cleaning, repair and hire of clothing"})
-create (_982:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S30102", `label`:"03.1.2 Garments"})
-create (_983:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G30200", `label`:"03.2 Footwear including repairs"})
-create (_984:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S30200", `label`:"03.2.0 Footwear including repairs"})
-create (_985:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T20000", `label`:"02 Alcoholic beverages and tobacco"})
-create (_986:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G20200", `label`:"02.2 Tobacco"})
-create (_987:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S20200", `label`:"02.2.0 Tobacco"})
-create (_988:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G20100", `label`:"02.1 Alcoholic beverages"})
-create (_989:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S20103", `label`:"02.1.3 Beer"})
-create (_990:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S20102", `label`:"02.1.2 Wine"})
-create (_991:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S20101", `label`:"02.1.1 Spirits"})
-create (_992:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T90000", `label`:"09 Recreation and culture"})
-create (_993:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G90500", `label`:"09.5 Books, newspapers and stationery"})
-create (_994:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90501", `label`:"09.5.1 Books"})
-create (_995:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90502", `label`:"09.5.2 Newspapers and periodicals"})
-create (_996:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90503", `label`:"09.5.3/4 Misc. printed matter, stationery, drawing materials"})
-create (_997:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G90400", `label`:"09.4 Recreational and cultural services"})
-create (_998:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90401", `label`:"09.4.1 Recreational and sporting services"})
-create (_999:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90402", `label`:"09.4.2 Cultural services"})
-create (_1000:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G90300", `label`:"09.3 Other recreational items, gardens and pets"})
-create (_1001:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90304", `label`:"09.3.4/5 Pets, related products and services"})
-create (_1002:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90303", `label`:"09.3.3 Gardens, plants and flowers"})
-create (_1003:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90302", `label`:"09.3.2 Equipment for sport and open-air recreation"})
-create (_1004:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90301", `label`:"09.3.1 Games, toys and hobbies"})
-create (_1005:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G90100", `label`:"09.1 Audio-visual equipment and related products"})
-create (_1006:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90105", `label`:"09.1.5 Repair of audio-visual equipment , related products"})
-create (_1007:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90103", `label`:"09.1.3 Data processing equipment"})
-create (_1008:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90104", `label`:"09.1.4 Recording media"})
-create (_1009:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90101", `label`:"09.1.1 Reception and reproduction of sound and pictures"})
-create (_1010:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90102", `label`:"09.1.2 Photographic, cinematographic and optical equipment"})
-create (_1011:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G90600", `label`:"09.6 Package holidays"})
-create (_1012:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S90600", `label`:"09.6.0 Package Holidays"})
-create (_1013:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T80000", `label`:"08 Communication"})
-create (_1014:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G80100", `label`:"08.1 Postal services"})
-create (_1015:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S80100", `label`:"08.1.0 Postal Services"})
-create (_1016:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G80200", `label`:"08.2/3 Telephone and telefax equip"})
-create (_1017:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T40000", `label`:"04 Housing, water, electricity, gas and other fuels"})
-create (_1018:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G40500", `label`:"04.5 Electricity , gas and other fuels"})
-create (_1019:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40503", `label`:"04.5.3 Liquid fuels"})
-create (_1020:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40504", `label`:"04.5.4 Solid fuels"})
-create (_1021:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40502", `label`:"04.5.2 Gas"})
-create (_1022:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40501", `label`:"04.5.1 Electricity"})
-create (_1023:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G40400", `label`:"04.4 Water supply and misc. services for the dwelling"})
-create (_1024:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40403", `label`:"04.4.3 Sewerage collection"})
-create (_1025:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40401", `label`:"04.4.1 Water supply"})
-create (_1026:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G40100", `label`:"04.1 Actual rentals for housing"})
-create (_1027:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40100", `label`:"04.1.0 Actual rentals for housing"})
-create (_1028:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G40300", `label`:"04.3 Regular maintenance and repair of the dwelling"})
-create (_1029:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40302", `label`:"04.3.2 Services for maintenance and repair"})
-create (_1030:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S40301", `label`:"04.3.1 Materials for maintenance and repair"})
-create (_1031:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T110000", `label`:"11 Restaurants and hotels"})
-create (_1032:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G110200", `label`:"11.2 Accommodation services"})
-create (_1033:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S110200", `label`:"11.2.0 Accommodation Services"})
-create (_1034:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G110100", `label`:"11.1 Catering services"})
-create (_1035:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S110101", `label`:"11.1.1 Restaurants, cafes"})
-create (_1036:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S110102", `label`:"11.1.2 Canteens"})
-create (_1037:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T120000", `label`:"12 Miscellaneous goods and services"})
-create (_1038:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G120300", `label`:"12.3 Personal effects (nec)"})
-create (_1039:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120301", `label`:"12.3.1 Jewellery, clocks and watches"})
-create (_1040:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120302", `label`:"12.3.2 Other personal effects"})
-create (_1041:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G120500", `label`:"12.5 Insurance"})
-create (_1042:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120503", `label`:"12.5.3 Health insurance"})
-create (_1043:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120504", `label`:"12.5.4 Transport insurance"})
-create (_1044:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120502", `label`:"12.5.2 House contents insurance"})
-create (_1045:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G120600", `label`:"12.6 Financial services (nec)"})
-create (_1046:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120602", `label`:"12.6.2 Other financial services (nec)"})
-create (_1047:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G120700", `label`:"12.7 Other services (nec)"})
-create (_1048:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120700", `label`:"12.7.0 Other Services Not Elsewhere covered"})
-create (_1049:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G120100", `label`:"12.1 Personal care"})
-create (_1050:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120102", `label`:"12.1.2/3 Appliances and products for personal care"})
-create (_1051:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120101", `label`:"12.1.1 Hairdressing and personal grooming establishments"})
-create (_1052:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G120400", `label`:"12.4 Social protection"})
-create (_1053:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S120400", `label`:"12.4.0 Social Protection"})
-create (_1054:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T50000", `label`:"05 Furniture, household equipment and maintenance"})
-create (_1055:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G50500", `label`:"05.5 Tools and equipment for house and garden"})
-create (_1056:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50500", `label`:"05.5.0 Tools and equipment for House and Garden"})
-create (_1057:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G50300", `label`:"05.3 Household appliances, fitting and repairs"})
-create (_1058:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50301", `label`:"05.3.1/2 Major appliances and small electric goods"})
-create (_1059:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50303", `label`:"05.3.3 Repair of household appliances"})
-create (_1060:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G50200", `label`:"05.2 Household textiles"})
-create (_1061:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50200", `label`:"05.2.0 Household Textiles"})
-create (_1062:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G50100", `label`:"05.1 Furniture, furnishings and carpets"})
-create (_1063:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50102", `label`:"05.1.2 Carpets and other floor coverings"})
-create (_1064:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50101", `label`:"05.1.1 Furniture and furnishings"})
-create (_1065:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G50600", `label`:"05.6 Goods and services for routine maintenance"})
-create (_1066:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50602", `label`:"05.6.2 Domestic services and household services"})
-create (_1067:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50601", `label`:"05.6.1 Non-durable household goods"})
-create (_1068:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G50400", `label`:"05.4 Glassware, tableware and household utensils"})
-create (_1069:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S50400", `label`:"05.4.0 Glassware, Tableware and Household Utensils"})
-create (_1070:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1T100000", `label`:"10 Education"})
-create (_1071:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1G100000", `label`:"10.0 Education"})
-create (_1072:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078` {`code`:"cpi1dim1S100000", `label`:"10.0.0 Education"})
-create (_1079:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S60102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.1.2/3 Other medical and therapeutic equipment", `numberOfChildren`:0})
-create (_1080:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S60101", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.1.1 Pharmaceutical products", `numberOfChildren`:0})
-create (_1081:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G60300", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.3 Hospital services", `numberOfChildren`:1})
-create (_1082:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S60300", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"06.3.0 Hospital Services", `numberOfChildren`:0})
-create (_1083:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T10000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01 Food and non-alcoholic beverages", `numberOfChildren`:2})
-create (_1084:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G10200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.2 Non-alcoholic beverages", `numberOfChildren`:2})
-create (_1085:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10201", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.2.1 Coffee, tea and cocoa", `numberOfChildren`:0})
-create (_1086:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10202", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.2.2 Mineral waters, soft drinks and juices", `numberOfChildren`:0})
-create (_1087:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G10100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1 Food", `numberOfChildren`:9})
-create (_1088:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10103", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.3 Fish", `numberOfChildren`:0})
-create (_1089:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10106", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.6 Fruit", `numberOfChildren`:0})
-create (_1090:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10108", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.8 Sugar, jam, syrups, chocolate and confectionery", `numberOfChildren`:0})
-create (_1091:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10107", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.7 Vegetables including potatoes and tubers", `numberOfChildren`:0})
-create (_1092:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10109", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.9 Food products (nec)", `numberOfChildren`:0})
-create (_1093:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10101", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.1 Bread and cereals", `numberOfChildren`:0})
-create (_1094:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10104", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.4 Milk, cheese and eggs", `numberOfChildren`:0})
-create (_1095:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10105", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.5 Oils and fats", `numberOfChildren`:0})
-create (_1096:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S10102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"01.1.2 Meat", `numberOfChildren`:0})
-create (_1097:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T70000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07 Transport", `numberOfChildren`:3})
-create (_1098:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G70100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.1 Purchase of vehicles", `numberOfChildren`:3})
-create (_1099:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.1.2/3 Motorcycles and bicycles", `numberOfChildren`:0})
-create (_1100:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70191", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.1.1b Second Hand Cars", `numberOfChildren`:0})
-create (_1101:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70181", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.1.1 New Cars", `numberOfChildren`:0})
-create (_1102:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G70300", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.3 Transport services", `numberOfChildren`:4})
-create (_1103:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70304", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.3.4 Passenger transport by sea and inland waterway", `numberOfChildren`:0})
-create (_1104:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70302", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.3.2 Passenger transport by road", `numberOfChildren`:0})
-create (_1105:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70303", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.3.3 Passenger transport by air", `numberOfChildren`:0})
-create (_1106:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70301", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.3.1 Passenger transport by railway", `numberOfChildren`:0})
-create (_1107:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G70200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.2 Operation of personal transport equipment", `numberOfChildren`:4})
-create (_1108:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70203", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.2.3 Maintenance and repairs", `numberOfChildren`:0})
-create (_1109:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70202", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.2.2 Fuels and lubricants", `numberOfChildren`:0})
-create (_1110:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70201", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.2.1 Spare parts and accessories", `numberOfChildren`:0})
-create (_1111:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S70204", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"07.2.4 Other services", `numberOfChildren`:0})
-create (_1112:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T30000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"03 Clothing and footwear", `numberOfChildren`:2})
-create (_1113:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G30100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"03.1 Clothing", `numberOfChildren`:3})
-create (_1114:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S30103", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"03.1.3 Other clothing and clothing accessories", `numberOfChildren`:0})
-create (_1115:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S30104", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"03.1.4 Cleaning, repair and hire of clothing", `numberOfChildren`:0})
-create (_1116:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S30102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"03.1.2 Garments", `numberOfChildren`:0})
-create (_1117:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G30200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"03.2 Footwear including repairs", `numberOfChildren`:1})
-create (_1118:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S30200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"03.2.0 Footwear including repairs", `numberOfChildren`:0})
-create (_1119:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T20000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"02 Alcoholic beverages and tobacco", `numberOfChildren`:2})
-create (_1120:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G20200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"02.2 Tobacco", `numberOfChildren`:1})
-create (_1121:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S20200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"02.2.0 Tobacco", `numberOfChildren`:0})
-create (_1122:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G20100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"02.1 Alcoholic beverages", `numberOfChildren`:3})
-create (_1123:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S20103", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"02.1.3 Beer", `numberOfChildren`:0})
-create (_1124:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S20102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"02.1.2 Wine", `numberOfChildren`:0})
-create (_1125:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S20101", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"02.1.1 Spirits", `numberOfChildren`:0})
-create (_1126:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T90000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09 Recreation and culture", `numberOfChildren`:5})
-create (_1127:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G90500", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.5 Books, newspapers and stationery", `numberOfChildren`:3})
-create (_1128:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90501", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.5.1 Books", `numberOfChildren`:0})
-create (_1129:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90502", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.5.2 Newspapers and periodicals", `numberOfChildren`:0})
-create (_1130:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90503", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.5.3/4 Misc. printed matter, stationery, drawing materials", `numberOfChildren`:0})
-create (_1131:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G90400", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.4 Recreational and cultural services", `numberOfChildren`:2})
-create (_1132:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90401", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.4.1 Recreational and sporting services", `numberOfChildren`:0})
-create (_1133:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90402", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.4.2 Cultural services", `numberOfChildren`:0})
-create (_1134:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G90300", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.3 Other recreational items, gardens and pets", `numberOfChildren`:4})
-create (_1135:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90304", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.3.4/5 Pets, related products and services", `numberOfChildren`:0})
-create (_1136:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90303", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.3.3 Gardens, plants and flowers", `numberOfChildren`:0})
-create (_1137:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90302", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.3.2 Equipment for sport and open-air recreation", `numberOfChildren`:0})
-create (_1138:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90301", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.3.1 Games, toys and hobbies", `numberOfChildren`:0})
-create (_1139:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G90100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.1 Audio-visual equipment and related products", `numberOfChildren`:5})
-create (_1140:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90105", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.1.5 Repair of audio-visual equipment , related products", `numberOfChildren`:0})
-create (_1141:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90103", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.1.3 Data processing equipment", `numberOfChildren`:0})
-create (_1142:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90104", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.1.4 Recording media", `numberOfChildren`:0})
-create (_1143:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90101", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.1.1 Reception and reproduction of sound and pictures", `numberOfChildren`:0})
-create (_1144:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.1.2 Photographic, cinematographic and optical equipment", `numberOfChildren`:0})
-create (_1145:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G90600", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.6 Package holidays", `numberOfChildren`:1})
-create (_1146:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S90600", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"09.6.0 Package Holidays", `numberOfChildren`:0})
-create (_1147:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T80000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"08 Communication", `numberOfChildren`:2})
-create (_1148:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G80100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"08.1 Postal services", `numberOfChildren`:1})
-create (_1149:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S80100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"08.1.0 Postal Services", `numberOfChildren`:0})
-create (_1150:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G80200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"08.2/3 Telephone and telefax equip", `numberOfChildren`:0})
-create (_1151:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T40000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04 Housing, water, electricity, gas and other fuels", `numberOfChildren`:4})
-create (_1152:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G40500", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.5 Electricity , gas and other fuels", `numberOfChildren`:4})
-create (_1153:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40503", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.5.3 Liquid fuels", `numberOfChildren`:0})
-create (_1154:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40504", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.5.4 Solid fuels", `numberOfChildren`:0})
-create (_1155:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40502", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.5.2 Gas", `numberOfChildren`:0})
-create (_1156:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40501", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.5.1 Electricity", `numberOfChildren`:0})
-create (_1157:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G40400", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.4 Water supply and misc. services for the dwelling", `numberOfChildren`:2})
-create (_1158:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40403", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.4.3 Sewerage collection", `numberOfChildren`:0})
-create (_1159:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40401", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.4.1 Water supply", `numberOfChildren`:0})
-create (_1160:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G40100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.1 Actual rentals for housing", `numberOfChildren`:1})
-create (_1161:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.1.0 Actual rentals for housing", `numberOfChildren`:0})
-create (_1162:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G40300", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.3 Regular maintenance and repair of the dwelling", `numberOfChildren`:2})
-create (_1163:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40302", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.3.2 Services for maintenance and repair", `numberOfChildren`:0})
-create (_1164:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S40301", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"04.3.1 Materials for maintenance and repair", `numberOfChildren`:0})
-create (_1165:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T110000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"11 Restaurants and hotels", `numberOfChildren`:2})
-create (_1166:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G110200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"11.2 Accommodation services", `numberOfChildren`:1})
-create (_1167:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S110200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"11.2.0 Accommodation Services", `numberOfChildren`:0})
-create (_1168:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G110100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"11.1 Catering services", `numberOfChildren`:2})
-create (_1169:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S110101", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"11.1.1 Restaurants, cafes", `numberOfChildren`:0})
-create (_1170:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S110102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"11.1.2 Canteens", `numberOfChildren`:0})
-create (_1171:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T120000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12 Miscellaneous goods and services", `numberOfChildren`:6})
-create (_1172:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G120300", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.3 Personal effects (nec)", `numberOfChildren`:2})
-create (_1173:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120301", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.3.1 Jewellery, clocks and watches", `numberOfChildren`:0})
-create (_1174:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120302", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.3.2 Other personal effects", `numberOfChildren`:0})
-create (_1175:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G120500", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.5 Insurance", `numberOfChildren`:3})
-create (_1176:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120503", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.5.3 Health insurance", `numberOfChildren`:0})
-create (_1177:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120504", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.5.4 Transport insurance", `numberOfChildren`:0})
-create (_1178:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120502", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.5.2 House contents insurance", `numberOfChildren`:0})
-create (_1179:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G120600", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.6 Financial services (nec)", `numberOfChildren`:1})
-create (_1180:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120602", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.6.2 Other financial services (nec)", `numberOfChildren`:0})
-create (_1181:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G120700", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.7 Other services (nec)", `numberOfChildren`:1})
-create (_1182:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120700", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.7.0 Other Services Not Elsewhere covered", `numberOfChildren`:0})
-create (_1183:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G120100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.1 Personal care", `numberOfChildren`:2})
-create (_1184:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.1.2/3 Appliances and products for personal care", `numberOfChildren`:0})
-create (_1185:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120101", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.1.1 Hairdressing and personal grooming establishments", `numberOfChildren`:0})
-create (_1186:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G120400", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.4 Social protection", `numberOfChildren`:1})
-create (_1187:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S120400", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"12.4.0 Social Protection", `numberOfChildren`:0})
-create (_1188:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T50000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05 Furniture, household equipment and maintenance", `numberOfChildren`:6})
-create (_1189:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G50500", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.5 Tools and equipment for house and garden", `numberOfChildren`:1})
-create (_1190:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50500", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.5.0 Tools and equipment for House and Garden", `numberOfChildren`:0})
-create (_1191:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G50300", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.3 Household appliances, fitting and repairs", `numberOfChildren`:2})
-create (_1192:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50301", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.3.1/2 Major appliances and small electric goods", `numberOfChildren`:0})
-create (_1193:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50303", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.3.3 Repair of household appliances", `numberOfChildren`:0})
-create (_1194:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G50200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.2 Household textiles", `numberOfChildren`:1})
-create (_1195:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50200", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.2.0 Household Textiles", `numberOfChildren`:0})
-create (_1196:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G50100", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.1 Furniture, furnishings and carpets", `numberOfChildren`:2})
-create (_1197:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50102", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.1.2 Carpets and other floor coverings", `numberOfChildren`:0})
-create (_1198:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50101", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.1.1 Furniture and furnishings", `numberOfChildren`:0})
-create (_1199:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G50600", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.6 Goods and services for routine maintenance", `numberOfChildren`:2})
-create (_1200:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50602", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.6.2 Domestic services and household services", `numberOfChildren`:0})
-create (_1201:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50601", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.6.1 Non-durable household goods", `numberOfChildren`:0})
-create (_1202:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G50400", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.4 Glassware, tableware and household utensils", `numberOfChildren`:1})
-create (_1203:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S50400", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"05.4.0 Glassware, Tableware and Household Utensils", `numberOfChildren`:0})
-create (_1204:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1T100000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"10 Education", `numberOfChildren`:1})
-create (_1205:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1G100000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"10.0 Education", `numberOfChildren`:1})
-create (_1206:`_hierarchy_node_{{ .Instance }}_aggregate` {`code`:"cpi1dim1S100000", `code_list`:"e44de4c4-d39e-4e2f-942b-3ca10584d078", `hasData`:true, `label`:"10.0.0 Education", `numberOfChildren`:0})
-create (_654)-[:`hasParent`]->(_653)
-create (_655)-[:`hasParent`]->(_654)
-create (_936)-[:`hasParent`]->(_655)
-create (_937)-[:`hasParent`]->(_655)
-create (_938)-[:`hasParent`]->(_654)
-create (_940)-[:`hasParent`]->(_939)
-create (_941)-[:`hasParent`]->(_940)
-create (_942)-[:`hasParent`]->(_941)
-create (_943)-[:`hasParent`]->(_941)
-create (_944)-[:`hasParent`]->(_940)
-create (_945)-[:`hasParent`]->(_944)
-create (_946)-[:`hasParent`]->(_944)
-create (_947)-[:`hasParent`]->(_940)
-create (_948)-[:`hasParent`]->(_947)
-create (_949)-[:`hasParent`]->(_939)
-create (_950)-[:`hasParent`]->(_949)
-create (_951)-[:`hasParent`]->(_950)
-create (_952)-[:`hasParent`]->(_950)
-create (_953)-[:`hasParent`]->(_949)
-create (_954)-[:`hasParent`]->(_953)
-create (_955)-[:`hasParent`]->(_953)
-create (_956)-[:`hasParent`]->(_953)
-create (_957)-[:`hasParent`]->(_953)
-create (_958)-[:`hasParent`]->(_953)
-create (_959)-[:`hasParent`]->(_953)
-create (_960)-[:`hasParent`]->(_953)
-create (_961)-[:`hasParent`]->(_953)
-create (_962)-[:`hasParent`]->(_953)
-create (_963)-[:`hasParent`]->(_939)
-create (_964)-[:`hasParent`]->(_963)
-create (_965)-[:`hasParent`]->(_964)
-create (_966)-[:`hasParent`]->(_964)
-create (_967)-[:`hasParent`]->(_964)
-create (_968)-[:`hasParent`]->(_963)
-create (_969)-[:`hasParent`]->(_968)
-create (_970)-[:`hasParent`]->(_968)
-create (_971)-[:`hasParent`]->(_968)
-create (_972)-[:`hasParent`]->(_968)
-create (_973)-[:`hasParent`]->(_963)
-create (_974)-[:`hasParent`]->(_973)
-create (_975)-[:`hasParent`]->(_973)
-create (_976)-[:`hasParent`]->(_973)
-create (_977)-[:`hasParent`]->(_973)
-create (_978)-[:`hasParent`]->(_939)
-create (_979)-[:`hasParent`]->(_978)
-create (_980)-[:`hasParent`]->(_979)
-create (_981)-[:`hasParent`]->(_979)
-create (_982)-[:`hasParent`]->(_979)
-create (_983)-[:`hasParent`]->(_978)
-create (_984)-[:`hasParent`]->(_983)
-create (_985)-[:`hasParent`]->(_939)
-create (_986)-[:`hasParent`]->(_985)
-create (_987)-[:`hasParent`]->(_986)
-create (_988)-[:`hasParent`]->(_985)
-create (_989)-[:`hasParent`]->(_988)
-create (_990)-[:`hasParent`]->(_988)
-create (_991)-[:`hasParent`]->(_988)
-create (_992)-[:`hasParent`]->(_939)
-create (_993)-[:`hasParent`]->(_992)
-create (_994)-[:`hasParent`]->(_993)
-create (_995)-[:`hasParent`]->(_993)
-create (_996)-[:`hasParent`]->(_993)
-create (_997)-[:`hasParent`]->(_992)
-create (_998)-[:`hasParent`]->(_997)
-create (_999)-[:`hasParent`]->(_997)
-create (_1000)-[:`hasParent`]->(_992)
-create (_1001)-[:`hasParent`]->(_1000)
-create (_1002)-[:`hasParent`]->(_1000)
-create (_1003)-[:`hasParent`]->(_1000)
-create (_1004)-[:`hasParent`]->(_1000)
-create (_1005)-[:`hasParent`]->(_992)
-create (_1006)-[:`hasParent`]->(_1005)
-create (_1007)-[:`hasParent`]->(_1005)
-create (_1008)-[:`hasParent`]->(_1005)
-create (_1009)-[:`hasParent`]->(_1005)
-create (_1010)-[:`hasParent`]->(_1005)
-create (_1011)-[:`hasParent`]->(_992)
-create (_1012)-[:`hasParent`]->(_1011)
-create (_1013)-[:`hasParent`]->(_939)
-create (_1014)-[:`hasParent`]->(_1013)
-create (_1015)-[:`hasParent`]->(_1014)
-create (_1016)-[:`hasParent`]->(_1013)
-create (_1017)-[:`hasParent`]->(_939)
-create (_1018)-[:`hasParent`]->(_1017)
-create (_1019)-[:`hasParent`]->(_1018)
-create (_1020)-[:`hasParent`]->(_1018)
-create (_1021)-[:`hasParent`]->(_1018)
-create (_1022)-[:`hasParent`]->(_1018)
-create (_1023)-[:`hasParent`]->(_1017)
-create (_1024)-[:`hasParent`]->(_1023)
-create (_1025)-[:`hasParent`]->(_1023)
-create (_1026)-[:`hasParent`]->(_1017)
-create (_1027)-[:`hasParent`]->(_1026)
-create (_1028)-[:`hasParent`]->(_1017)
-create (_1029)-[:`hasParent`]->(_1028)
-create (_1030)-[:`hasParent`]->(_1028)
-create (_1031)-[:`hasParent`]->(_939)
-create (_1032)-[:`hasParent`]->(_1031)
-create (_1033)-[:`hasParent`]->(_1032)
-create (_1034)-[:`hasParent`]->(_1031)
-create (_1035)-[:`hasParent`]->(_1034)
-create (_1036)-[:`hasParent`]->(_1034)
-create (_1037)-[:`hasParent`]->(_939)
-create (_1038)-[:`hasParent`]->(_1037)
-create (_1039)-[:`hasParent`]->(_1038)
-create (_1040)-[:`hasParent`]->(_1038)
-create (_1041)-[:`hasParent`]->(_1037)
-create (_1042)-[:`hasParent`]->(_1041)
-create (_1043)-[:`hasParent`]->(_1041)
-create (_1044)-[:`hasParent`]->(_1041)
-create (_1045)-[:`hasParent`]->(_1037)
-create (_1046)-[:`hasParent`]->(_1045)
-create (_1047)-[:`hasParent`]->(_1037)
-create (_1048)-[:`hasParent`]->(_1047)
-create (_1049)-[:`hasParent`]->(_1037)
-create (_1050)-[:`hasParent`]->(_1049)
-create (_1051)-[:`hasParent`]->(_1049)
-create (_1052)-[:`hasParent`]->(_1037)
-create (_1053)-[:`hasParent`]->(_1052)
-create (_1054)-[:`hasParent`]->(_939)
-create (_1055)-[:`hasParent`]->(_1054)
-create (_1056)-[:`hasParent`]->(_1055)
-create (_1057)-[:`hasParent`]->(_1054)
-create (_1058)-[:`hasParent`]->(_1057)
-create (_1059)-[:`hasParent`]->(_1057)
-create (_1060)-[:`hasParent`]->(_1054)
-create (_1061)-[:`hasParent`]->(_1060)
-create (_1062)-[:`hasParent`]->(_1054)
-create (_1063)-[:`hasParent`]->(_1062)
-create (_1064)-[:`hasParent`]->(_1062)
-create (_1065)-[:`hasParent`]->(_1054)
-create (_1066)-[:`hasParent`]->(_1065)
-create (_1067)-[:`hasParent`]->(_1065)
-create (_1068)-[:`hasParent`]->(_1054)
-create (_1069)-[:`hasParent`]->(_1068)
-create (_1070)-[:`hasParent`]->(_939)
-create (_1071)-[:`hasParent`]->(_1070)
-create (_1072)-[:`hasParent`]->(_1071)
-create (_1079)-[:`hasParent`]->(_938)
-create (_1080)-[:`hasParent`]->(_938)
-create (_1081)-[:`hasParent`]->(_654)
-create (_1082)-[:`hasParent`]->(_1081)
-create (_1083)-[:`hasParent`]->(_653)
-create (_1084)-[:`hasParent`]->(_1083)
-create (_1085)-[:`hasParent`]->(_1084)
-create (_1086)-[:`hasParent`]->(_1084)
-create (_1087)-[:`hasParent`]->(_1083)
-create (_1088)-[:`hasParent`]->(_1087)
-create (_1089)-[:`hasParent`]->(_1087)
-create (_1090)-[:`hasParent`]->(_1087)
-create (_1091)-[:`hasParent`]->(_1087)
-create (_1092)-[:`hasParent`]->(_1087)
-create (_1093)-[:`hasParent`]->(_1087)
-create (_1094)-[:`hasParent`]->(_1087)
-create (_1095)-[:`hasParent`]->(_1087)
-create (_1096)-[:`hasParent`]->(_1087)
-create (_1097)-[:`hasParent`]->(_653)
-create (_1098)-[:`hasParent`]->(_1097)
-create (_1099)-[:`hasParent`]->(_1098)
-create (_1100)-[:`hasParent`]->(_1098)
-create (_1101)-[:`hasParent`]->(_1098)
-create (_1102)-[:`hasParent`]->(_1097)
-create (_1103)-[:`hasParent`]->(_1102)
-create (_1104)-[:`hasParent`]->(_1102)
-create (_1105)-[:`hasParent`]->(_1102)
-create (_1106)-[:`hasParent`]->(_1102)
-create (_1107)-[:`hasParent`]->(_1097)
-create (_1108)-[:`hasParent`]->(_1107)
-create (_1109)-[:`hasParent`]->(_1107)
-create (_1110)-[:`hasParent`]->(_1107)
-create (_1111)-[:`hasParent`]->(_1107)
-create (_1112)-[:`hasParent`]->(_653)
-create (_1113)-[:`hasParent`]->(_1112)
-create (_1114)-[:`hasParent`]->(_1113)
-create (_1115)-[:`hasParent`]->(_1113)
-create (_1116)-[:`hasParent`]->(_1113)
-create (_1117)-[:`hasParent`]->(_1112)
-create (_1118)-[:`hasParent`]->(_1117)
-create (_1119)-[:`hasParent`]->(_653)
-create (_1120)-[:`hasParent`]->(_1119)
-create (_1121)-[:`hasParent`]->(_1120)
-create (_1122)-[:`hasParent`]->(_1119)
-create (_1123)-[:`hasParent`]->(_1122)
-create (_1124)-[:`hasParent`]->(_1122)
-create (_1125)-[:`hasParent`]->(_1122)
-create (_1126)-[:`hasParent`]->(_653)
-create (_1127)-[:`hasParent`]->(_1126)
-create (_1128)-[:`hasParent`]->(_1127)
-create (_1129)-[:`hasParent`]->(_1127)
-create (_1130)-[:`hasParent`]->(_1127)
-create (_1131)-[:`hasParent`]->(_1126)
-create (_1132)-[:`hasParent`]->(_1131)
-create (_1133)-[:`hasParent`]->(_1131)
-create (_1134)-[:`hasParent`]->(_1126)
-create (_1135)-[:`hasParent`]->(_1134)
-create (_1136)-[:`hasParent`]->(_1134)
-create (_1137)-[:`hasParent`]->(_1134)
-create (_1138)-[:`hasParent`]->(_1134)
-create (_1139)-[:`hasParent`]->(_1126)
-create (_1140)-[:`hasParent`]->(_1139)
-create (_1141)-[:`hasParent`]->(_1139)
-create (_1142)-[:`hasParent`]->(_1139)
-create (_1143)-[:`hasParent`]->(_1139)
-create (_1144)-[:`hasParent`]->(_1139)
-create (_1145)-[:`hasParent`]->(_1126)
-create (_1146)-[:`hasParent`]->(_1145)
-create (_1147)-[:`hasParent`]->(_653)
-create (_1148)-[:`hasParent`]->(_1147)
-create (_1149)-[:`hasParent`]->(_1148)
-create (_1150)-[:`hasParent`]->(_1147)
-create (_1151)-[:`hasParent`]->(_653)
-create (_1152)-[:`hasParent`]->(_1151)
-create (_1153)-[:`hasParent`]->(_1152)
-create (_1154)-[:`hasParent`]->(_1152)
-create (_1155)-[:`hasParent`]->(_1152)
-create (_1156)-[:`hasParent`]->(_1152)
-create (_1157)-[:`hasParent`]->(_1151)
-create (_1158)-[:`hasParent`]->(_1157)
-create (_1159)-[:`hasParent`]->(_1157)
-create (_1160)-[:`hasParent`]->(_1151)
-create (_1161)-[:`hasParent`]->(_1160)
-create (_1162)-[:`hasParent`]->(_1151)
-create (_1163)-[:`hasParent`]->(_1162)
-create (_1164)-[:`hasParent`]->(_1162)
-create (_1165)-[:`hasParent`]->(_653)
-create (_1166)-[:`hasParent`]->(_1165)
-create (_1167)-[:`hasParent`]->(_1166)
-create (_1168)-[:`hasParent`]->(_1165)
-create (_1169)-[:`hasParent`]->(_1168)
-create (_1170)-[:`hasParent`]->(_1168)
-create (_1171)-[:`hasParent`]->(_653)
-create (_1172)-[:`hasParent`]->(_1171)
-create (_1173)-[:`hasParent`]->(_1172)
-create (_1174)-[:`hasParent`]->(_1172)
-create (_1175)-[:`hasParent`]->(_1171)
-create (_1176)-[:`hasParent`]->(_1175)
-create (_1177)-[:`hasParent`]->(_1175)
-create (_1178)-[:`hasParent`]->(_1175)
-create (_1179)-[:`hasParent`]->(_1171)
-create (_1180)-[:`hasParent`]->(_1179)
-create (_1181)-[:`hasParent`]->(_1171)
-create (_1182)-[:`hasParent`]->(_1181)
-create (_1183)-[:`hasParent`]->(_1171)
-create (_1184)-[:`hasParent`]->(_1183)
-create (_1185)-[:`hasParent`]->(_1183)
-create (_1186)-[:`hasParent`]->(_1171)
-create (_1187)-[:`hasParent`]->(_1186)
-create (_1188)-[:`hasParent`]->(_653)
-create (_1189)-[:`hasParent`]->(_1188)
-create (_1190)-[:`hasParent`]->(_1189)
-create (_1191)-[:`hasParent`]->(_1188)
-create (_1192)-[:`hasParent`]->(_1191)
-create (_1193)-[:`hasParent`]->(_1191)
-create (_1194)-[:`hasParent`]->(_1188)
-create (_1195)-[:`hasParent`]->(_1194)
-create (_1196)-[:`hasParent`]->(_1188)
-create (_1197)-[:`hasParent`]->(_1196)
-create (_1198)-[:`hasParent`]->(_1196)
-create (_1199)-[:`hasParent`]->(_1188)
-create (_1200)-[:`hasParent`]->(_1199)
-create (_1201)-[:`hasParent`]->(_1199)
-create (_1202)-[:`hasParent`]->(_1188)
-create (_1203)-[:`hasParent`]->(_1202)
-create (_1204)-[:`hasParent`]->(_653)
-create (_1205)-[:`hasParent`]->(_1204)
-create (_1206)-[:`hasParent`]->(_1205)
-;
@@ -0,0 +1,439 @@
+package neo4j
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// batchSize is the number of rows unwound in a single round trip to neo4j
+const batchSize = 1000
+
+// LoadStats represents what was written to neo4j during a load and how long it took
+type LoadStats struct {
+	Nodes         int64
+	Relationships int64
+	Duration      time.Duration
+}
+
+func (s *LoadStats) add(metadata map[string]interface{}) {
+	stats, ok := metadata["stats"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if n, ok := stats["nodes-created"].(int64); ok {
+		s.Nodes += n
+	}
+	if r, ok := stats["relationships-created"].(int64); ok {
+		s.Relationships += r
+	}
+}
+
+func (s *LoadStats) logData(data log.Data) log.Data {
+	data["nodes_created"] = s.Nodes
+	data["relationships_created"] = s.Relationships
+	data["duration"] = s.Duration.String()
+	return data
+}
+
+// transaction runs fn within a single neo4j transaction, everything written
+// by fn is rolled back if it returns an error
+func (ds *Datastore) transaction(fn func() error) error {
+	tx, err := ds.connection.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err = fn(); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.ErrorC("failed to rollback neo4j transaction", rollbackErr, nil)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SetupFixture loads all nodes and relationships of a fixture into neo4j in a
+// single transaction. Nodes are created in batches per label set and
+// relationships in batches per type, using UNWIND over parameter lists.
+func (ds *Datastore) SetupFixture(f *Fixture) (*LoadStats, error) {
+	return ds.load(f.InstanceID, nil, f.writeTo)
+}
+
+// StreamFixture loads the fixture built for an instance in a single
+// transaction, sending its nodes and relationships to neo4j in batches as
+// build adds them, so the whole fixture is never held in memory
+func (ds *Datastore) StreamFixture(instanceID string, build func(f *Fixture) error) (*LoadStats, error) {
+	return ds.load(instanceID, nil, func(w fixtureWriter) error {
+		return build(&Fixture{InstanceID: instanceID, writer: w})
+	})
+}
+
+// ReplaceCodeLists removes the given code lists and loads a code list fixture
+// in their place, in a single transaction so a failure leaves the code lists
+// as they were
+func (ds *Datastore) ReplaceCodeLists(f *Fixture, codeListIDs ...string) (*LoadStats, error) {
+	return ds.load(f.InstanceID, func() error {
+		for _, codeListID := range codeListIDs {
+			if _, err := ds.TeardownCodeList(codeListID, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, f.writeTo)
+}
+
+// writeTo passes every node and then every relationship of the fixture to w
+func (f *Fixture) writeTo(w fixtureWriter) error {
+	for _, n := range f.Nodes {
+		if err := w.writeNode(n); err != nil {
+			return err
+		}
+	}
+	for _, r := range f.Relationships {
+		if err := w.writeRelationship(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load runs write against a batch writer within a transaction, after running
+// before in the same transaction if it is given
+func (ds *Datastore) load(instanceID string, before func() error, write func(w fixtureWriter) error) (*LoadStats, error) {
+	start := time.Now()
+	stats := &LoadStats{}
+	logData := log.Data{"instance_id": instanceID}
+
+	err := ds.transaction(func() error {
+		if before != nil {
+			if err := before(); err != nil {
+				return err
+			}
+		}
+
+		w := newBatchWriter(ds, stats, logData)
+		if err := write(w); err != nil {
+			return err
+		}
+		return w.flush()
+	})
+	if err != nil {
+		log.ErrorC("encountered error writing fixture to neo4j, transaction rolled back", err, logData)
+		return nil, err
+	}
+
+	stats.Duration = time.Since(start)
+	log.Info("successfully loaded fixture into neo4j", stats.logData(logData))
+	return stats, nil
+}
+
+// batchWriter holds the nodes of each label set and the relationships of each
+// type written to it until a batch is full, then sends the batch to neo4j
+type batchWriter struct {
+	ds      *Datastore
+	stats   *LoadStats
+	logData log.Data
+
+	// neo4j ids of the nodes sent so far, keyed by ref
+	ids map[string]int64
+
+	nodes             map[string][]*GraphNode
+	labelSets         []string
+	relationships     map[string][]*GraphRelationship
+	relationshipTypes []string
+}
+
+func newBatchWriter(ds *Datastore, stats *LoadStats, logData log.Data) *batchWriter {
+	return &batchWriter{
+		ds:            ds,
+		stats:         stats,
+		logData:       logData,
+		ids:           make(map[string]int64),
+		nodes:         make(map[string][]*GraphNode),
+		relationships: make(map[string][]*GraphRelationship),
+	}
+}
+
+func (w *batchWriter) writeNode(n *GraphNode) error {
+	w.ds.record(n.Labels...)
+	labels := cypherLabels(n.Labels)
+	if _, ok := w.nodes[labels]; !ok {
+		w.labelSets = append(w.labelSets, labels)
+	}
+
+	w.nodes[labels] = append(w.nodes[labels], n)
+	if len(w.nodes[labels]) < batchSize {
+		return nil
+	}
+	return w.flushNodes(labels)
+}
+
+func (w *batchWriter) writeRelationship(r *GraphRelationship) error {
+	if _, ok := w.relationships[r.Type]; !ok {
+		w.relationshipTypes = append(w.relationshipTypes, r.Type)
+	}
+
+	w.relationships[r.Type] = append(w.relationships[r.Type], r)
+	if len(w.relationships[r.Type]) < batchSize {
+		return nil
+	}
+
+	// the nodes of the relationships may still be waiting to be sent
+	for _, labels := range w.labelSets {
+		if err := w.flushNodes(labels); err != nil {
+			return err
+		}
+	}
+	return w.flushRelationships(r.Type)
+}
+
+// flush sends everything still held, nodes first so every relationship can
+// be matched to its nodes
+func (w *batchWriter) flush() error {
+	for _, labels := range w.labelSets {
+		if err := w.flushNodes(labels); err != nil {
+			return err
+		}
+	}
+	for _, relationshipType := range w.relationshipTypes {
+		if err := w.flushRelationships(relationshipType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *batchWriter) flushNodes(labels string) error {
+	group := w.nodes[labels]
+	if len(group) == 0 {
+		return nil
+	}
+
+	var rows []interface{}
+	for _, n := range group {
+		rows = append(rows, map[string]interface{}{"ref": n.Ref, "properties": boltProperties(n.Properties)})
+	}
+
+	query := fmt.Sprintf("UNWIND $rows AS row CREATE (n%s) SET n = row.properties RETURN row.ref, id(n)", labels)
+	if err := w.ds.createNodes(query, rows, w.ids, w.stats); err != nil {
+		w.logData["labels"] = labels
+		return err
+	}
+
+	w.nodes[labels] = group[:0]
+	return nil
+}
+
+func (w *batchWriter) flushRelationships(relationshipType string) error {
+	group := w.relationships[relationshipType]
+	if len(group) == 0 {
+		return nil
+	}
+
+	var rows []interface{}
+	for _, r := range group {
+		from, ok := w.ids[r.From]
+		if !ok {
+			return fmt.Errorf("relationship references unknown node %s", r.From)
+		}
+		to, ok := w.ids[r.To]
+		if !ok {
+			return fmt.Errorf("relationship references unknown node %s", r.To)
+		}
+		rows = append(rows, map[string]interface{}{"from": from, "to": to, "properties": boltProperties(r.Properties)})
+	}
+
+	query := fmt.Sprintf("UNWIND $rows AS row MATCH (a) WHERE id(a) = row.from MATCH (b) WHERE id(b) = row.to CREATE (a)-[r:`%s`]->(b) SET r = row.properties", relationshipType)
+	result, err := w.ds.connection.ExecNeo(query, map[string]interface{}{"rows": rows})
+	if err != nil {
+		w.logData["relationship_type"] = relationshipType
+		return err
+	}
+	w.stats.add(result.Metadata())
+
+	w.relationships[relationshipType] = group[:0]
+	return nil
+}
+
+// createNodes runs a node creation query and records the neo4j id of each
+// created node against the ref it was created with
+func (ds *Datastore) createNodes(query string, rows []interface{}, ids map[string]int64, stats *LoadStats) error {
+	results, err := ds.connection.QueryNeo(query, map[string]interface{}{"rows": rows})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	for {
+		row, metadata, err := results.NextNeo()
+		if err == io.EOF {
+			stats.add(metadata)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		ref, ok := row[0].(string)
+		if !ok {
+			return fmt.Errorf("unexpected node reference returned from neo4j: %v", row[0])
+		}
+		id, ok := row[1].(int64)
+		if !ok {
+			return fmt.Errorf("unexpected node id returned from neo4j: %v", row[1])
+		}
+		ids[ref] = id
+	}
+}
+
+// SetupGenericHierarchy loads a hierarchy definition as generic hierarchy
+// nodes for its code list in place of any already there, in a single
+// transaction
+func (ds *Datastore) SetupGenericHierarchy(h *HierarchyDefinition) (*LoadStats, error) {
+	return ds.setupGenericHierarchy(h.CodeListID, func(fn func(n *HierarchyNode) error) error {
+		for _, n := range h.Nodes {
+			if err := fn(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SetupGenericHierarchyFile loads the generic hierarchy of a code list from a
+// csv file with the columns code, label and parent_code, sending its rows to
+// neo4j in batches as they are read
+func (ds *Datastore) SetupGenericHierarchyFile(codeListID, filename string) (*LoadStats, error) {
+	return ds.setupGenericHierarchy(codeListID, func(fn func(n *HierarchyNode) error) error {
+		return readHierarchyNodes(filename, fn)
+	})
+}
+
+// setupGenericHierarchy loads the nodes passed to fn by each, which is called
+// once to create the nodes and again to link them to their parents. A
+// constraint can not be created in the same transaction as the nodes, so one
+// created for the load is dropped again if the load is rolled back.
+func (ds *Datastore) setupGenericHierarchy(codeListID string, each func(fn func(n *HierarchyNode) error) error) (*LoadStats, error) {
+	start := time.Now()
+	stats := &LoadStats{}
+	name := fmt.Sprintf("_generic_hierarchy_node_%s", codeListID)
+	label := fmt.Sprintf("`%s`", name)
+	logData := log.Data{"code_list_id": codeListID}
+	ds.record(name)
+
+	constraint := fmt.Sprintf("CONSTRAINT ON (n:%s) ASSERT n.code IS UNIQUE", label)
+	existing, err := ds.hasUniqueCodeConstraint(name)
+	if err != nil {
+		log.ErrorC("failed to check constraint for generic hierarchy", err, logData)
+		return nil, err
+	}
+	if !existing {
+		if _, err = ds.connection.ExecNeo("CREATE "+constraint, nil); err != nil {
+			log.ErrorC("failed to create constraint for generic hierarchy", err, logData)
+			return nil, err
+		}
+	}
+
+	err = ds.transaction(func() error {
+		if _, err := ds.connection.ExecNeo(fmt.Sprintf("MATCH (n:%s) DETACH DELETE n", label), nil); err != nil {
+			return err
+		}
+
+		createNodes := fmt.Sprintf("UNWIND $rows AS row CREATE (n:%s) SET n.code = row.code, n.label = row.label", label)
+		createRelationships := fmt.Sprintf("UNWIND $rows AS row MATCH (n:%s { code: row.code }), (p:%s { code: row.parent }) CREATE (n)-[:hasParent]->(p)", label, label)
+
+		for _, query := range []string{createNodes, createRelationships} {
+			var rows []interface{}
+			send := func() error {
+				if len(rows) == 0 {
+					return nil
+				}
+				result, err := ds.connection.ExecNeo(query, map[string]interface{}{"rows": rows})
+				if err != nil {
+					return err
+				}
+				stats.add(result.Metadata())
+				rows = nil
+				return nil
+			}
+
+			err := each(func(n *HierarchyNode) error {
+				if query == createRelationships && n.Parent == "" {
+					return nil
+				}
+				rows = append(rows, map[string]interface{}{"code": n.Code, "label": n.Label, "parent": n.Parent})
+				if len(rows) < batchSize {
+					return nil
+				}
+				return send()
+			})
+			if err != nil {
+				return err
+			}
+			if err = send(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !existing {
+			if _, dropErr := ds.connection.ExecNeo("DROP "+constraint, nil); dropErr != nil {
+				log.ErrorC("failed to drop constraint for generic hierarchy", dropErr, logData)
+			}
+		}
+		log.ErrorC("encountered error writing generic hierarchy to neo4j, transaction rolled back", err, logData)
+		return nil, err
+	}
+
+	stats.Duration = time.Since(start)
+	log.Info("successfully loaded generic hierarchy into neo4j", stats.logData(logData))
+	return stats, nil
+}
+
+// hasUniqueCodeConstraint returns whether nodes with the label already have a
+// unique constraint on their code
+func (ds *Datastore) hasUniqueCodeConstraint(label string) (bool, error) {
+	constraints, err := ds.queryStrings("CALL db.constraints() YIELD description RETURN description")
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range constraints {
+		if strings.Contains(c, label) && strings.Contains(c, ".code IS UNIQUE") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// boltProperties converts property values into types the bolt driver can encode
+func boltProperties(properties map[string]interface{}) map[string]interface{} {
+	converted := make(map[string]interface{})
+	for k, v := range properties {
+		if list, ok := v.([]string); ok {
+			var values []interface{}
+			for _, s := range list {
+				values = append(values, s)
+			}
+			converted[k] = values
+			continue
+		}
+		converted[k] = v
+	}
+	return converted
+}
+
+func cypherLabels(labels []string) string {
+	var l string
+	for _, label := range labels {
+		l += fmt.Sprintf(":`%s`", label)
+	}
+	return l
+}
@@ -1,35 +1,26 @@
 package neo4j
 
 import (
-	"bufio"
-	"bytes"
 	"errors"
 	"fmt"
-	"html/template"
-	"os"
+	"io"
 
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
 	"github.com/ONSdigital/go-ns/log"
 	bolt "github.com/johnnadratowski/golang-neo4j-bolt-driver"
 	"github.com/johnnadratowski/golang-neo4j-bolt-driver/structures/graph"
-	. "github.com/smartystreets/goconvey/convey"
 )
 
-const ObservationTestData = "../../testDataSetup/neo4j/instance.cypher"
-const HierarchyTestData = "../../testDataSetup/neo4j/hierarchy.cypher"
-const GenericHierarchyCPIHTestData = "../testDataSetup/neo4j/genericHierarchyCPIH.cypher"
-
-const codeListCPIHTestData = "../testDataSetup/neo4j/codeListCPIH.cypher"
+// GenericHierarchyCPIHTestData is the CPIH hierarchy definition as seen from
+// the end to end tests
+const GenericHierarchyCPIHTestData = "../testDataSetup/neo4j/hierarchyCPIH.csv"
 
 // Datastore used to setup data within neo4j
 type Datastore struct {
 	instance   string
 	testData   string
 	connection bolt.Conn
-}
-
-// CypherTemplate allows cypher queries to be updated with new ID
-type CypherTemplate struct {
-	Instance string
+	labels     map[string]bool
 }
 
 // NewDatastore creates a new datastore for a test
@@ -41,142 +32,101 @@ func NewDatastore(uri, instance, testdata string) (*Datastore, error) {
 	return &Datastore{connection: driver, instance: instance, testData: testdata}, nil
 }
 
-// TeardownInstance removes all instance nodes within neo4j
-func (ds *Datastore) TeardownInstance() error {
-	query := fmt.Sprintf("MATCH (n:`_%s_Instance`)-[r]-(m)-[t]-(o) detach delete n,m,o;", ds.instance)
-	results, err := ds.connection.QueryNeo(query, nil)
+// SetupInstance connects to neo4j and streams in the fixture build makes for
+// the instance, returning the datastore the instance is torn down with
+func SetupInstance(uri, instanceID string, build func(f *Fixture) error) (*Datastore, error) {
+	ds, err := NewDatastore(uri, instanceID, "")
 	if err != nil {
-		return err
+		return nil, err
 	}
-	results.Close()
-	return ds.connection.Close()
-}
 
-// TeardownHierarchy removes all hierarchy nodes within neo4j
-func (ds *Datastore) TeardownHierarchy() error {
-	_, err := ds.connection.ExecNeo("MATCH (n:`_generic_hierarchy_node_e44de4c4-d39e-4e2f-942b-3ca10584d078`) DETACH DELETE n", nil)
-	if err != nil {
-		return err
-	}
-	query := fmt.Sprintf("MATCH (n:`_hierarchy_node_%s_aggregate`) DETACH DELETE n", ds.instance)
-	_, err = ds.connection.ExecNeo(query, nil)
-	if err != nil {
-		return err
+	if _, err = ds.StreamFixture(instanceID, build); err != nil {
+		if closeErr := ds.Close(); closeErr != nil {
+			log.ErrorC("SetupInstance", closeErr, log.Data{"instance_id": instanceID})
+		}
+		return nil, err
 	}
+
+	return ds, nil
+}
+
+// Close the connection to neo4j
+func (ds *Datastore) Close() error {
 	return ds.connection.Close()
 }
 
-// Setup the neo4j database
-func (ds *Datastore) Setup() error {
-	t, err := template.ParseFiles(ds.testData)
-	if err != nil {
-		return err
-	}
-	query := new(bytes.Buffer)
-	t.Execute(query, CypherTemplate{Instance: ds.instance})
-	_, err = ds.connection.ExecNeo(query.String(), nil)
-	if err != nil {
+// TeardownInstance removes all nodes with a label belonging to the instance
+func (ds *Datastore) TeardownInstance() error {
+	if _, err := ds.TeardownInstanceLabels(ds.instance, false); err != nil {
 		return err
 	}
-	return err
+	return ds.connection.Close()
 }
 
-// CreateGenericHierarchy the neo4j database
-func (ds *Datastore) CreateGenericHierarchy(hierarchyCode string) error {
-	_, err := ds.connection.ExecNeo("MATCH (n:`_generic_hierarchy_node_"+hierarchyCode+"`) DETACH DELETE n", nil)
-	if err != nil {
+// TeardownHierarchy removes all hierarchy nodes created by Setup and any
+// other nodes belonging to the instance
+func (ds *Datastore) TeardownHierarchy() error {
+	if _, err := ds.TeardownCreated(false); err != nil {
 		return err
 	}
-
-	file, err := os.Open(ds.testData)
-	if err != nil {
+	if _, err := ds.TeardownInstanceLabels(ds.instance, false); err != nil {
 		return err
 	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			log.ErrorC("CreateGenericHierarchy", err, nil)
-		}
-	}()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		_, err = ds.connection.ExecNeo(line, nil)
-		if err != nil {
-			log.ErrorC("encountered error writing query to neo4j", err, log.Data{"cypher_file": ds.testData, "cypher_line": scanner.Text()})
-			return err
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
-		return err
-	}
-
-	log.Info("successfully loaded data into neo4j", log.Data{"cypher_file": ds.testData})
-	return nil
+	return ds.connection.Close()
 }
 
-func (ds *Datastore) CreateCPIHCodeList() error {
-	_, err := ds.connection.ExecNeo("MATCH (n:`_code`) DETACH DELETE n", nil)
-	if err != nil {
-		return err
-	}
+// CreateGenericHierarchy replaces the generic hierarchy of a code list with
+// the hierarchy definition the datastore was created with
+func (ds *Datastore) CreateGenericHierarchy(codeListID string) error {
+	_, err := ds.SetupGenericHierarchyFile(codeListID, ds.testData)
+	return err
+}
 
-	_, err = ds.connection.ExecNeo("MATCH (n:`_code_list`) DETACH DELETE n", nil)
+// CreateCodeLists replaces the code lists used by the dimensions of a V4 file,
+// leaving any other code lists in place
+func (ds *Datastore) CreateCodeLists(v4File string) error {
+	file, err := v4.ParseFile(v4File)
 	if err != nil {
 		return err
 	}
 
-	file, err := os.Open(codeListCPIHTestData)
+	codeLists := NewV4CodeLists(file, "one-off")
+	fixture, err := NewCodeListFixture(codeLists)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			log.ErrorC("CreateCPIHCodeList", err, nil)
-		}
-	}()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		_, err = ds.connection.ExecNeo(line, nil)
-		if err != nil {
-			log.ErrorC("encountered error writing query to neo4j", err, log.Data{"cypher_file": codeListCPIHTestData, "cypher_line": scanner.Text()})
-			return err
-		}
-	}
 
-	if err := scanner.Err(); err != nil {
-		return err
+	var codeListIDs []string
+	for _, cl := range codeLists {
+		codeListIDs = append(codeListIDs, cl.ID)
 	}
 
-	log.Info("successfully loaded data into neo4j", log.Data{"cypher_file": codeListCPIHTestData})
-
-	return nil
+	_, err = ds.ReplaceCodeLists(fixture, codeListIDs...)
+	return err
 }
 
 // GetInstanceProperties returns a map of properties that are stored on the instance node
 func (ds *Datastore) GetInstanceProperties(instanceID string) (map[string]interface{}, error) {
-
-	query := fmt.Sprintf("MATCH (i:`_%s_Instance`) RETURN i", instanceID)
-	stmt, err := ds.connection.PrepareNeo(query)
-	So(err, ShouldBeNil)
-	defer stmt.Close()
-
-	rows, err := stmt.QueryNeo(nil)
-	So(err, ShouldBeNil)
+	rows, err := ds.connection.QueryNeo(fmt.Sprintf("MATCH (i:`_%s_Instance`) RETURN i", instanceID), nil)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
 	data, _, err := rows.NextNeo()
+	if err == io.EOF {
+		return nil, ErrInstanceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	nodeData := data[0]
-	graphNode, ok := nodeData.(graph.Node)
-	if ok {
-		return graphNode.Properties, nil
+	graphNode, ok := data[0].(graph.Node)
+	if !ok {
+		return nil, errors.New("failed to retrieve properties from neo4j instance node")
 	}
 
-	return nil, errors.New("failed to retrieve properties from neo4j instance node")
+	return graphNode.Properties, nil
 }
 
 // CreateInstanceNode creates a new instance node for the given instance ID
@@ -221,7 +171,9 @@ func (ds *Datastore) CleanUpInstance(instanceID string) error {
 	if err != nil {
 		return err
 	}
-	So(count, ShouldEqual, int64(1))
+	if count != 1 {
+		return fmt.Errorf("expected to remove 1 instance node, removed %d", count)
+	}
 
 	log.Info("cleaning up test instance complete", log.Data{"instanceID": instanceID})
 
@@ -0,0 +1,271 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// ErrInstanceNotFound is returned when no instance node exists for an instance id
+var ErrInstanceNotFound = errors.New("instance node not found")
+
+// ErrHierarchyNodeNotFound is returned when a code does not exist within a hierarchy
+var ErrHierarchyNodeNotFound = errors.New("hierarchy node not found")
+
+// HierarchyTreeNode represents a node of an instance hierarchy as stored in
+// neo4j, along with all of its children
+type HierarchyTreeNode struct {
+	Code             string
+	Label            string
+	CodeList         string
+	HasData          bool
+	NumberOfChildren int64
+	Parent           string
+	Children         []*HierarchyTreeNode
+}
+
+// Size returns the number of nodes in the tree, including this node
+func (n *HierarchyTreeNode) Size() int {
+	size := 1
+	for _, child := range n.Children {
+		size += child.Size()
+	}
+	return size
+}
+
+// CountObservations returns the number of observation nodes for an instance
+func (ds *Datastore) CountObservations(instanceID string) (int64, error) {
+	return ds.count(fmt.Sprintf("MATCH (o:`_%s_observation`) RETURN count(o)", instanceID))
+}
+
+// GetDimensionOptions returns the sorted option values of each dimension of
+// an instance, keyed by dimension name
+func (ds *Datastore) GetDimensionOptions(instanceID string) (map[string][]string, error) {
+	dimensions, err := ds.instanceDimensions(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make(map[string][]string)
+	for _, dimension := range dimensions {
+		query := fmt.Sprintf("MATCH (:`_%s_Instance`)-[:HAS_DIMENSION]->(o:`_%s_%s`) RETURN o.value", instanceID, instanceID, dimension)
+		values, err := ds.queryStrings(query)
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Strings(values)
+		options[dimension] = values
+	}
+
+	return options, nil
+}
+
+// GetDimensionOptionNodeIDs returns the node id of each option of each
+// dimension of an instance, keyed by dimension name then option value
+func (ds *Datastore) GetDimensionOptionNodeIDs(instanceID string) (map[string]map[string]string, error) {
+	dimensions, err := ds.instanceDimensions(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeIDs := make(map[string]map[string]string)
+	for _, dimension := range dimensions {
+		query := fmt.Sprintf("MATCH (:`_%s_Instance`)-[:HAS_DIMENSION]->(o:`_%s_%s`) RETURN id(o), o.value", instanceID, instanceID, dimension)
+		ids, err := ds.queryNodeIDs(query)
+		if err != nil {
+			return nil, err
+		}
+
+		nodeIDs[dimension] = ids
+	}
+
+	return nodeIDs, nil
+}
+
+// GetHierarchyNodes returns every hierarchy node of an instance dimension,
+// keyed by code. Children are sorted by code.
+func (ds *Datastore) GetHierarchyNodes(instanceID, dimension string) (map[string]*HierarchyTreeNode, error) {
+	query := fmt.Sprintf("MATCH (n:`_hierarchy_node_%s_%s`) OPTIONAL MATCH (n)-[:hasParent]->(p) "+
+		"RETURN n.code, n.label, n.code_list, n.hasData, n.numberOfChildren, p.code", instanceID, dimension)
+
+	rows, err := ds.connection.QueryNeo(query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nodes := make(map[string]*HierarchyTreeNode)
+	for {
+		row, _, err := rows.NextNeo()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		code, _ := row[0].(string)
+		if _, ok := nodes[code]; ok {
+			return nil, fmt.Errorf("hierarchy node %s has more than one parent or is duplicated", code)
+		}
+
+		node := &HierarchyTreeNode{Code: code}
+		node.Label, _ = row[1].(string)
+		node.CodeList, _ = row[2].(string)
+		node.HasData, _ = row[3].(bool)
+		node.NumberOfChildren, _ = row[4].(int64)
+		node.Parent, _ = row[5].(string)
+		nodes[code] = node
+	}
+
+	var codes []string
+	for code := range nodes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		node := nodes[code]
+		if parent, ok := nodes[node.Parent]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	return nodes, nil
+}
+
+// GetHierarchySubtree returns the hierarchy of an instance dimension starting
+// at the given code, or at the root node if no code is given
+func (ds *Datastore) GetHierarchySubtree(instanceID, dimension, code string) (*HierarchyTreeNode, error) {
+	nodes, err := ds.GetHierarchyNodes(instanceID, dimension)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != "" {
+		node, ok := nodes[code]
+		if !ok {
+			return nil, ErrHierarchyNodeNotFound
+		}
+		return node, nil
+	}
+
+	var root *HierarchyTreeNode
+	for _, node := range nodes {
+		if node.Parent == "" {
+			if root != nil {
+				return nil, fmt.Errorf("hierarchy has more than one root: %s and %s", root.Code, node.Code)
+			}
+			root = node
+		}
+	}
+
+	if root == nil {
+		return nil, ErrHierarchyNodeNotFound
+	}
+
+	return root, nil
+}
+
+// instanceDimensions returns the names of the dimensions listed on the instance node
+func (ds *Datastore) instanceDimensions(instanceID string) ([]string, error) {
+	props, err := ds.GetInstanceProperties(instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := props["dimensions"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("instance node has no list of dimensions: %v", props["dimensions"])
+	}
+
+	var dimensions []string
+	for _, d := range list {
+		dimension, ok := d.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected dimension on instance node: %v", d)
+		}
+		dimensions = append(dimensions, dimension)
+	}
+
+	return dimensions, nil
+}
+
+// count runs a query returning a single count
+func (ds *Datastore) count(query string) (int64, error) {
+	rows, err := ds.connection.QueryNeo(query, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	row, _, err := rows.NextNeo()
+	if err != nil {
+		return 0, err
+	}
+
+	count, ok := row[0].(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected count returned from neo4j: %v", row[0])
+	}
+
+	return count, nil
+}
+
+// queryNodeIDs runs a query returning node ids and values, returning the ids
+// keyed by value
+func (ds *Datastore) queryNodeIDs(query string) (map[string]string, error) {
+	rows, err := ds.connection.QueryNeo(query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[string]string)
+	for {
+		row, _, err := rows.NextNeo()
+		if err == io.EOF {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		id, ok := row[0].(int64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node id returned from neo4j: %v", row[0])
+		}
+
+		value, _ := row[1].(string)
+		ids[value] = strconv.FormatInt(id, 10)
+	}
+}
+
+// queryStrings runs a query returning a single string column
+func (ds *Datastore) queryStrings(query string) ([]string, error) {
+	rows, err := ds.connection.QueryNeo(query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []string
+	for {
+		row, _, err := rows.NextNeo()
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value, ok := row[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value returned from neo4j: %v", row[0])
+		}
+		values = append(values, value)
+	}
+}
@@ -0,0 +1,160 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidHierarchyShape is returned when a synthetic hierarchy cannot be generated
+var ErrInvalidHierarchyShape = errors.New("invalid synthetic hierarchy shape")
+
+// unicodeLabels are cycled through when a synthetic hierarchy uses unicode labels
+var unicodeLabels = []string{
+	"Ünïcödé ☃",
+	"日本語のラベル",
+	"Ελληνικά",
+	"Кириллица",
+	"عربى",
+	"emoji 🍞🥛",
+	"Combining é",
+}
+
+// HierarchyShape describes the shape of a synthetic hierarchy. The tree grows
+// level by level below a single root, each node having up to FanOut children,
+// until it is Depth levels deep. Once a level has MaxLeaves nodes each level
+// below it has the same number of nodes, one child for each parent.
+type HierarchyShape struct {
+	Depth     int
+	FanOut    int
+	MaxLeaves int
+
+	// NoDataEvery marks every nth node above the leaves as having no data,
+	// zero keeps data on every node
+	NoDataEvery int
+
+	// ChainLength adds a chain of nodes below the root where each node has a
+	// single child, zero adds no chain
+	ChainLength int
+
+	// UnicodeLabels uses multi-byte and right to left labels instead of ascii
+	UnicodeLabels bool
+}
+
+// SyntheticHierarchy is a generated hierarchy and the codes of the dimension
+// options it was generated with
+type SyntheticHierarchy struct {
+	Definition *HierarchyDefinition
+	Options    map[string]bool
+	Root       string
+	Leaves     []string
+	Depth      int
+}
+
+// NewSyntheticHierarchy generates a hierarchy for a code list in the given shape.
+// Every leaf is an option of the dimension, so every node remains once cloned
+// for an instance.
+func NewSyntheticHierarchy(codeListID string, shape HierarchyShape) (*SyntheticHierarchy, error) {
+	if shape.Depth < 0 || (shape.Depth > 0 && shape.FanOut < 1) || shape.MaxLeaves < 0 || shape.NoDataEvery < 0 || shape.ChainLength < 0 {
+		return nil, ErrInvalidHierarchyShape
+	}
+
+	s := &SyntheticHierarchy{
+		Definition: &HierarchyDefinition{CodeListID: codeListID},
+		Options:    make(map[string]bool),
+	}
+
+	var internal int
+	add := func(parent string, level int) string {
+		code := fmt.Sprintf("%s_%d", codeListID, len(s.Definition.Nodes))
+		s.Definition.Nodes = append(s.Definition.Nodes, &HierarchyNode{
+			Code:   code,
+			Label:  syntheticLabel(code, level, len(s.Definition.Nodes), shape.UnicodeLabels),
+			Parent: parent,
+		})
+		if level > s.Depth {
+			s.Depth = level
+		}
+		return code
+	}
+
+	s.Root = add("", 0)
+	level := []string{s.Root}
+
+	for depth := 1; depth <= shape.Depth; depth++ {
+		size := len(level) * shape.FanOut
+		if shape.MaxLeaves > 0 && size > shape.MaxLeaves {
+			size = shape.MaxLeaves
+		}
+
+		// spread the children across the parents so every parent has at
+		// least one child and none has more than the fan out
+		next := make([]string, size)
+		for i := range next {
+			next[i] = add(level[i%len(level)], depth)
+		}
+
+		for _, parent := range level {
+			internal++
+			s.Options[parent] = shape.NoDataEvery == 0 || internal%shape.NoDataEvery != 0
+		}
+		level = next
+	}
+
+	if shape.Depth == 0 && shape.ChainLength > 0 {
+		// the root is the top of the chain rather than a leaf
+		s.Options[s.Root] = shape.NoDataEvery != 1
+		level = nil
+	}
+
+	for _, leaf := range level {
+		s.Options[leaf] = true
+		s.Leaves = append(s.Leaves, leaf)
+	}
+
+	parent := s.Root
+	for i := 0; i < shape.ChainLength; i++ {
+		parent = add(parent, i+1)
+		s.Options[parent] = i == shape.ChainLength-1
+	}
+	if shape.ChainLength > 0 {
+		s.Leaves = append(s.Leaves, parent)
+	}
+
+	return s, nil
+}
+
+// NewSyntheticHierarchyFixture builds an instance with a single dimension whose
+// options are the synthetic hierarchy options with data, and the hierarchy
+// the hierarchy builder would create for that dimension
+func NewSyntheticHierarchyFixture(instanceID, dimension string, s *SyntheticHierarchy) (*Fixture, error) {
+	f := &Fixture{InstanceID: instanceID}
+
+	instance := f.addNode([]string{fmt.Sprintf("_%s_Instance", instanceID)}, map[string]interface{}{
+		"dimensions": []string{dimension},
+	})
+
+	options := make(map[string]string)
+	for _, n := range s.Definition.Nodes {
+		if !s.Options[n.Code] {
+			continue
+		}
+		option := f.addNode([]string{fmt.Sprintf("_%s_%s", instanceID, dimension)}, map[string]interface{}{
+			"value": n.Code,
+		})
+		options[n.Code] = option
+		f.addRelationship(instance, option, "HAS_DIMENSION")
+	}
+
+	if err := f.addHierarchy(dimension, s.Definition, options); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func syntheticLabel(code string, level, i int, unicode bool) string {
+	if unicode {
+		return fmt.Sprintf("%s %d.%d", unicodeLabels[i%len(unicodeLabels)], level, i)
+	}
+	return fmt.Sprintf("Level %d %s", level, code)
+}
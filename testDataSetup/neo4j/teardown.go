@@ -0,0 +1,271 @@
+package neo4j
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// sharedLabels are used by every code list so are never removed by label
+var sharedLabels = map[string]bool{"_code": true, "_code_list": true}
+
+// ErrResidueFound is logged when instance data is left in neo4j after a test suite has run
+var ErrResidueFound = errors.New("test data left behind in neo4j")
+
+// residuePattern matches labels created for an instance, which are prefixed
+// with the instance id
+var residuePattern = regexp.MustCompile(`^_(hierarchy_node_)?[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}_`)
+
+// TeardownReport lists, for each label, the number of nodes removed or, in a
+// dry run, the number of nodes that would have been removed
+type TeardownReport struct {
+	DryRun bool
+	Nodes  map[string]int64
+}
+
+func newTeardownReport(dryRun bool) *TeardownReport {
+	return &TeardownReport{DryRun: dryRun, Nodes: make(map[string]int64)}
+}
+
+// Total returns the number of nodes across all labels in the report
+func (r *TeardownReport) Total() int64 {
+	var total int64
+	for _, count := range r.Nodes {
+		total += count
+	}
+	return total
+}
+
+func (r *TeardownReport) merge(other *TeardownReport) {
+	for label, count := range other.Nodes {
+		r.Nodes[label] += count
+	}
+}
+
+// InstanceLabelPrefixes returns the prefixes of all labels created for an
+// instance by the import pipeline and the hierarchy builder
+func InstanceLabelPrefixes(instanceID string) []string {
+	return []string{
+		fmt.Sprintf("_%s_", instanceID),
+		fmt.Sprintf("_hierarchy_node_%s_", instanceID),
+	}
+}
+
+// record keeps track of the labels written by this datastore so they can be
+// removed by TeardownCreated
+func (ds *Datastore) record(labels ...string) {
+	if ds.labels == nil {
+		ds.labels = make(map[string]bool)
+	}
+	for _, label := range labels {
+		ds.labels[label] = true
+	}
+}
+
+// TeardownInstanceLabels removes every node with a label belonging to the
+// given instance, including isolated nodes and hierarchy nodes
+func (ds *Datastore) TeardownInstanceLabels(instanceID string, dryRun bool) (*TeardownReport, error) {
+	labels, err := ds.labelsWithPrefix(InstanceLabelPrefixes(instanceID)...)
+	if err != nil {
+		log.ErrorC("failed to list instance labels in neo4j", err, log.Data{"instance_id": instanceID})
+		return nil, err
+	}
+
+	return ds.TeardownLabels(labels, dryRun)
+}
+
+// TeardownCodeList removes a code list, its generic hierarchy and any codes
+// not used by another code list
+func (ds *Datastore) TeardownCodeList(codeListID string, dryRun bool) (*TeardownReport, error) {
+	logData := log.Data{"code_list_id": codeListID, "dry_run": dryRun}
+	codeList := fmt.Sprintf("_code_list_%s", codeListID)
+
+	// codes are merged by value so may be shared between code lists
+	match := fmt.Sprintf("MATCH (c:`_code`)-[:usedBy]->(:`%s`) WHERE size((c)-[:usedBy]->()) = 1", codeList)
+	query := match + " RETURN count(c)"
+	if !dryRun {
+		query = match + " DETACH DELETE c RETURN count(c)"
+	}
+
+	count, err := ds.count(query)
+	if err != nil {
+		log.ErrorC("failed to remove codes for code list", err, logData)
+		return nil, err
+	}
+
+	report, err := ds.TeardownLabels([]string{codeList, fmt.Sprintf("_generic_hierarchy_node_%s", codeListID)}, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	report.Nodes["_code"] += count
+
+	return report, nil
+}
+
+// TeardownCreated removes every node with a label written through this
+// datastore. Code lists are removed with TeardownCodeList so codes shared
+// with other code lists are left in place.
+func (ds *Datastore) TeardownCreated(dryRun bool) (*TeardownReport, error) {
+	var labels []string
+	for label := range ds.labels {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	report := newTeardownReport(dryRun)
+	var remaining []string
+	for _, label := range labels {
+		if sharedLabels[label] {
+			continue
+		}
+
+		if strings.HasPrefix(label, "_code_list_") {
+			codeListReport, err := ds.TeardownCodeList(strings.TrimPrefix(label, "_code_list_"), dryRun)
+			if err != nil {
+				return nil, err
+			}
+			report.merge(codeListReport)
+			continue
+		}
+
+		remaining = append(remaining, label)
+	}
+
+	labelReport, err := ds.TeardownLabels(remaining, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	report.merge(labelReport)
+
+	if !dryRun {
+		ds.labels = nil
+	}
+
+	return report, nil
+}
+
+// TeardownLabels removes every node with one of the given labels. In a dry run
+// nothing is removed and the nodes that would have been are logged instead.
+func (ds *Datastore) TeardownLabels(labels []string, dryRun bool) (*TeardownReport, error) {
+	report := newTeardownReport(dryRun)
+
+	for _, label := range labels {
+		if sharedLabels[label] {
+			return nil, fmt.Errorf("refusing to remove all nodes with shared label %s", label)
+		}
+
+		query := fmt.Sprintf("MATCH (n:`%s`) RETURN count(n)", label)
+		if !dryRun {
+			query = fmt.Sprintf("MATCH (n:`%s`) DETACH DELETE n RETURN count(n)", label)
+		}
+
+		count, err := ds.count(query)
+		if err != nil {
+			log.ErrorC("failed to remove nodes from neo4j", err, log.Data{"label": label, "dry_run": dryRun})
+			return nil, err
+		}
+
+		if count > 0 {
+			report.Nodes[label] = count
+		}
+	}
+
+	if dryRun {
+		log.Info("neo4j teardown dry run, nodes would be removed", log.Data{"nodes": report.Nodes, "total": report.Total()})
+	} else {
+		log.Info("removed test data from neo4j", log.Data{"nodes": report.Nodes, "total": report.Total()})
+	}
+
+	return report, nil
+}
+
+// ScanResidue returns the number of nodes for every instance label still in
+// neo4j, so data left behind by a test suite can be reported
+func (ds *Datastore) ScanResidue() (map[string]int64, error) {
+	labels, err := ds.labelsWithPrefix("_")
+	if err != nil {
+		return nil, err
+	}
+
+	residue := make(map[string]int64)
+	for _, label := range labels {
+		if !residuePattern.MatchString(label) {
+			continue
+		}
+
+		// labels remain in neo4j after their nodes are removed, so count them
+		count, err := ds.count(fmt.Sprintf("MATCH (n:`%s`) RETURN count(n)", label))
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			residue[label] = count
+		}
+	}
+
+	return residue, nil
+}
+
+// CheckResidue scans neo4j for instance data left behind once a test suite
+// has run, returning the exit code of the suite, or 1 if any data is found
+func CheckResidue(uri string, code int) int {
+	ds, err := NewDatastore(uri, "", "")
+	if err != nil {
+		log.ErrorC("unable to connect to neo4j to scan for residue", err, nil)
+		return 1
+	}
+	defer ds.connection.Close()
+
+	residue, err := ds.ScanResidue()
+	if err != nil {
+		log.ErrorC("unable to scan neo4j for residue", err, nil)
+		return 1
+	}
+
+	if len(residue) > 0 {
+		log.ErrorC("test data has been left behind in neo4j", ErrResidueFound, log.Data{"nodes": residue})
+		return 1
+	}
+
+	return code
+}
+
+// labelsWithPrefix returns all labels known to neo4j starting with one of the prefixes
+func (ds *Datastore) labelsWithPrefix(prefixes ...string) ([]string, error) {
+	rows, err := ds.connection.QueryNeo("CALL db.labels() YIELD label RETURN label", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var labels []string
+	for {
+		row, _, err := rows.NextNeo()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		label, ok := row[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected label returned from neo4j: %v", row[0])
+		}
+
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(label, prefix) {
+				labels = append(labels, label)
+				break
+			}
+		}
+	}
+
+	sort.Strings(labels)
+	return labels, nil
+}
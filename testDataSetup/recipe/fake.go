@@ -0,0 +1,168 @@
+package recipe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/ONSdigital/dp-api-tests/recipeAPIModels"
+	"github.com/ONSdigital/go-ns/log"
+	"github.com/gorilla/mux"
+)
+
+// ErrNotStarted is returned when a fake recipe API is used before it has been started
+var ErrNotStarted = errors.New("fake recipe API has not been started")
+
+// FakeAPI is a stand-in for the recipe API serving recipes held in memory, so
+// the import API can be pointed at recipes set up by a test
+type FakeAPI struct {
+	mutex    sync.RWMutex
+	recipes  map[string][]byte
+	valid    map[string]recipeAPIModels.Recipe
+	server   *http.Server
+	listener net.Listener
+}
+
+// NewFakeAPI returns a fake recipe API serving the given recipes
+func NewFakeAPI(recipes ...recipeAPIModels.Recipe) (*FakeAPI, error) {
+	f := &FakeAPI{
+		recipes: make(map[string][]byte),
+		valid:   make(map[string]recipeAPIModels.Recipe),
+	}
+
+	for _, r := range recipes {
+		if err := f.Add(r); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// Add serves the recipe, replacing any recipe with the same ID
+func (f *FakeAPI) Add(r recipeAPIModels.Recipe) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.recipes[r.ID] = b
+	f.valid[r.ID] = r
+	return nil
+}
+
+// AddMalformed serves the body as-is for the recipe ID. Malformed recipes are
+// only returned when requested by ID and are left out of the recipe list
+func (f *FakeAPI) AddMalformed(id string, body []byte) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.recipes[id] = body
+	delete(f.valid, id)
+}
+
+// Remove stops serving the recipe with the given ID
+func (f *FakeAPI) Remove(id string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.recipes, id)
+	delete(f.valid, id)
+}
+
+// Start listens on the bind address and serves recipes in the background
+func (f *FakeAPI) Start(bindAddr string) error {
+	listener, err := net.Listen("tcp", bindAddr)
+	if err != nil {
+		return err
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/healthcheck", f.healthcheck).Methods("GET")
+	router.HandleFunc("/recipes", f.getRecipes).Methods("GET")
+	router.HandleFunc("/recipes/{id}", f.getRecipe).Methods("GET")
+
+	f.listener = listener
+	f.server = &http.Server{Handler: router}
+
+	go func() {
+		if err := f.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.ErrorC("fake recipe API stopped serving", err, log.Data{"bind_addr": bindAddr})
+		}
+	}()
+
+	log.Info("fake recipe API started", log.Data{"url": f.URL()})
+	return nil
+}
+
+// URL returns the address the fake recipe API is listening on
+func (f *FakeAPI) URL() string {
+	if f.listener == nil {
+		return ""
+	}
+	return "http://" + f.listener.Addr().String()
+}
+
+// Close stops the fake recipe API
+func (f *FakeAPI) Close(ctx context.Context) error {
+	if f.server == nil {
+		return ErrNotStarted
+	}
+	return f.server.Shutdown(ctx)
+}
+
+func (f *FakeAPI) healthcheck(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (f *FakeAPI) getRecipes(w http.ResponseWriter, r *http.Request) {
+	f.mutex.RLock()
+	items := make([]recipeAPIModels.Recipe, 0, len(f.valid))
+	for _, recipe := range f.valid {
+		items = append(items, recipe)
+	}
+	f.mutex.RUnlock()
+
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+
+	list := recipeAPIModels.RecipeList{
+		Count:        len(items),
+		Start:        0,
+		ItemsPerPage: len(items),
+		Items:        items,
+		TotalCount:   len(items),
+	}
+
+	b, err := json.Marshal(list)
+	if err != nil {
+		log.ErrorC("failed to marshal recipe list", err, nil)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
+
+func (f *FakeAPI) getRecipe(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	f.mutex.RLock()
+	b, ok := f.recipes[id]
+	f.mutex.RUnlock()
+
+	if !ok {
+		http.Error(w, "recipe not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
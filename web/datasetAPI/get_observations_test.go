@@ -16,7 +16,6 @@ import (
 )
 
 const (
-	observationTestData          = "../../testDataSetup/neo4j/instance.cypher"
 	expectedNumberOfObservations = 137
 )
 
@@ -29,13 +28,8 @@ func TestSuccessfullyGetObservationsForVersion(t *testing.T) {
 
 	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
 
-	publishedGraphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, observationTestData)
+	publishedGraphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 	if err != nil {
-		log.ErrorC("Unable to connect to a neo4j instance", err, nil)
-		os.Exit(1)
-	}
-
-	if err = publishedGraphData.Setup(); err != nil {
 		log.ErrorC("Unable to setup graph data", err, nil)
 		os.Exit(1)
 	}
@@ -150,24 +144,14 @@ func TestFailureToGetObservationsForVersion(t *testing.T) {
 
 	datasetAPI := httpexpect.New(t, cfg.DatasetAPIURL)
 
-	publishedGraphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, observationTestData)
+	publishedGraphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 	if err != nil {
-		log.ErrorC("Unable to connect to a neo4j instance", err, nil)
-		os.Exit(1)
-	}
-
-	if err = publishedGraphData.Setup(); err != nil {
 		log.ErrorC("Unable to setup graph data", err, nil)
 		os.Exit(1)
 	}
 
-	unpublishedGraphData, err := neo4j.NewDatastore(cfg.Neo4jAddr, unpublishedInstanceID, observationTestData)
+	unpublishedGraphData, err := neo4j.SetupInstance(cfg.Neo4jAddr, unpublishedInstanceID, neo4j.BuildCPIInstance)
 	if err != nil {
-		log.ErrorC("Unable to connect to a neo4j instance", err, nil)
-		os.Exit(1)
-	}
-
-	if err := unpublishedGraphData.Setup(); err != nil {
 		log.ErrorC("Unable to setup graph data", err, nil)
 		os.Exit(1)
 	}
@@ -20,18 +20,13 @@ func TestSuccessfullyGetNodeHierarchy(t *testing.T) {
 
 	Convey("Given an existing hierarchy", t, func() {
 
-		datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, neo4j.HierarchyTestData)
+		datastore, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 		if err != nil {
-			log.ErrorC("Unable to connect to neo4j", err, nil)
+			log.ErrorC("Unable to setup graph data", err, nil)
 			os.Exit(1)
 		}
-		Convey("When a root hierarchy node is requested", func() {
 
-			err := datastore.Setup()
-			if err != nil {
-				log.ErrorC("Unable to setup test data", err, nil)
-				os.Exit(1)
-			}
+		Convey("When a child hierarchy node is requested", func() {
 
 			Convey("Then a child hierarchy node is return as a response", func() {
 				response := hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension_name}/{code}", instanceID, "aggregate", cpiCode).
@@ -75,15 +70,9 @@ func TestErrorStatesGetNodeHierarchy(t *testing.T) {
 
 	Convey("Given an existing hierarchy", t, func() {
 
-		datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, neo4j.HierarchyTestData)
+		datastore, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 		if err != nil {
-			log.ErrorC("Unable to connect to neo4j", err, nil)
-			os.Exit(1)
-		}
-
-		err = datastore.Setup()
-		if err != nil {
-			log.ErrorC("Unable to setup test data", err, nil)
+			log.ErrorC("Unable to setup graph data", err, nil)
 			os.Exit(1)
 		}
 		// This should return 400 but returns 404
@@ -20,18 +20,13 @@ func TestSuccessfullyGetRootHierarchy(t *testing.T) {
 
 	Convey("Given an existing hierarchy", t, func() {
 
-		datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, neo4j.HierarchyTestData)
+		datastore, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 		if err != nil {
-			log.ErrorC("Unable to connect to neo4j", err, nil)
+			log.ErrorC("Unable to setup graph data", err, nil)
 			os.Exit(1)
 		}
-		Convey("When a root hierarchy node is requested", func() {
 
-			err := datastore.Setup()
-			if err != nil {
-				log.ErrorC("Unable to setup test data", err, nil)
-				os.Exit(1)
-			}
+		Convey("When a root hierarchy node is requested", func() {
 
 			Convey("Then a root hierarchy node is return as a response", func() {
 				response := hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension_name}", instanceID, "aggregate").
@@ -74,14 +69,9 @@ func TestErrorStatesGetRootHierarchy(t *testing.T) {
 	hierarchyAPI := httpexpect.New(t, cfg.HierarchyAPIURL)
 
 	Convey("Given an existing hierarchy", t, func() {
-		datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, neo4j.HierarchyTestData)
-		if err != nil {
-			log.ErrorC("Unable to connect to neo4j", err, nil)
-			os.Exit(1)
-		}
-		err = datastore.Setup()
+		datastore, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIInstance)
 		if err != nil {
-			log.ErrorC("Unable to setup test data", err, nil)
+			log.ErrorC("Unable to setup graph data", err, nil)
 			os.Exit(1)
 		}
 		// This should return 400 but returns 404
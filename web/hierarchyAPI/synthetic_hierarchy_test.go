@@ -0,0 +1,165 @@
+package hierarchyAPI
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/hierarchy"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+	"github.com/ONSdigital/go-ns/log"
+	"github.com/gavv/httpexpect"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const syntheticDimension = "synthetic"
+
+type syntheticCase struct {
+	description string
+	shape       neo4j.HierarchyShape
+}
+
+func TestSyntheticHierarchyEdgeCases(t *testing.T) {
+	hierarchyAPI := httpexpect.New(t, cfg.HierarchyAPIURL)
+
+	cases := []syntheticCase{
+		{"a wide hierarchy with a thousand children of the root", neo4j.HierarchyShape{Depth: 1, FanOut: 1000}},
+		{"a hierarchy made of a single child chain", neo4j.HierarchyShape{ChainLength: 50}},
+		{"a hierarchy with nodes that have no data", neo4j.HierarchyShape{Depth: 3, FanOut: 3, NoDataEvery: 2}},
+		{"a hierarchy with unicode labels", neo4j.HierarchyShape{Depth: 2, FanOut: 4, UnicodeLabels: true}},
+		{"a deep hierarchy with single child chains below the widest level", neo4j.HierarchyShape{Depth: 6, FanOut: 2, MaxLeaves: 8, ChainLength: 3}},
+	}
+
+	for _, c := range cases {
+		c := c
+		instanceID := uuid.NewV4().String()
+
+		Convey("Given "+c.description, t, func() {
+			synthetic, datastore := setupSyntheticHierarchy(instanceID, c.shape)
+			leaf := synthetic.Leaves[len(synthetic.Leaves)-1]
+
+			Convey("When the whole hierarchy is walked from the root node", func() {
+				validator := &hierarchy.Validator{
+					HierarchyAPIURL: cfg.HierarchyAPIURL,
+					Options:         synthetic.Options,
+				}
+
+				result, err := validator.Validate(context.Background(), instanceID, syntheticDimension)
+
+				Convey("Then every generated node is reached and every invariant holds", func() {
+					So(err, ShouldBeNil)
+					So(result.Nodes, ShouldEqual, len(synthetic.Definition.Nodes))
+					So(result.Depth, ShouldEqual, synthetic.Depth+1)
+					So(result.Violations, ShouldBeEmpty)
+				})
+			})
+
+			Convey("When the last leaf of the hierarchy is requested", func() {
+				response := hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension_name}/{code}", instanceID, syntheticDimension, leaf).
+					Expect().Status(http.StatusOK).JSON().Object()
+
+				Convey("Then the leaf is returned with its label and a breadcrumb for every ancestor", func() {
+					response.Value("label").String().Equal(syntheticLabel(synthetic, leaf))
+					response.Value("has_data").Boolean().True()
+					response.Value("no_of_children").Number().Equal(0)
+					response.Value("breadcrumbs").Array().Last().Object().
+						Value("label").String().Equal(syntheticLabel(synthetic, synthetic.Root))
+				})
+			})
+
+			teardownSyntheticHierarchy(datastore)
+		})
+	}
+}
+
+// TestSyntheticHierarchyResponseTimes logs how long the root node, and the
+// deepest leaf, take to be returned as the hierarchy grows
+func TestSyntheticHierarchyResponseTimes(t *testing.T) {
+	hierarchyAPI := httpexpect.New(t, cfg.HierarchyAPIURL)
+
+	for _, leaves := range []int{50, 500, 5000} {
+		leaves := leaves
+		instanceID := uuid.NewV4().String()
+		shape := neo4j.HierarchyShape{Depth: 10, FanOut: 5, MaxLeaves: leaves, NoDataEvery: 7}
+
+		Convey("Given a hierarchy 10 levels deep with up to a fan out of 5", t, func() {
+			synthetic, datastore := setupSyntheticHierarchy(instanceID, shape)
+			leaf := synthetic.Leaves[len(synthetic.Leaves)-1]
+
+			Convey("When the root node and the deepest leaf are requested", func() {
+				start := time.Now()
+				root := hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension_name}", instanceID, syntheticDimension).
+					Expect().Status(http.StatusOK).JSON().Object()
+				rootDuration := time.Since(start)
+
+				start = time.Now()
+				hierarchyAPI.GET("/hierarchies/{instance_id}/{dimension_name}/{code}", instanceID, syntheticDimension, leaf).
+					Expect().Status(http.StatusOK).JSON().Object().
+					Value("breadcrumbs").Array().Length().Equal(synthetic.Depth)
+				leafDuration := time.Since(start)
+
+				log.Info("synthetic hierarchy response times", log.Data{
+					"instance_id":   instanceID,
+					"nodes":         len(synthetic.Definition.Nodes),
+					"leaves":        len(synthetic.Leaves),
+					"depth":         synthetic.Depth,
+					"root_duration": rootDuration.String(),
+					"leaf_duration": leafDuration.String(),
+				})
+
+				Convey("Then the root node is returned with all of its children", func() {
+					root.Value("no_of_children").Number().Equal(shape.FanOut)
+					root.Value("children").Array().Length().Equal(shape.FanOut)
+				})
+			})
+
+			teardownSyntheticHierarchy(datastore)
+		})
+	}
+}
+
+func setupSyntheticHierarchy(instanceID string, shape neo4j.HierarchyShape) (*neo4j.SyntheticHierarchy, *neo4j.Datastore) {
+	synthetic, err := neo4j.NewSyntheticHierarchy("synthetic"+instanceID[:8], shape)
+	if err != nil {
+		log.ErrorC("Unable to generate synthetic hierarchy", err, nil)
+		os.Exit(1)
+	}
+
+	fixture, err := neo4j.NewSyntheticHierarchyFixture(instanceID, syntheticDimension, synthetic)
+	if err != nil {
+		log.ErrorC("Unable to build synthetic hierarchy fixture", err, nil)
+		os.Exit(1)
+	}
+
+	datastore, err := neo4j.NewDatastore(cfg.Neo4jAddr, instanceID, "")
+	if err != nil {
+		log.ErrorC("Unable to connect to neo4j", err, nil)
+		os.Exit(1)
+	}
+
+	if _, err = datastore.SetupFixture(fixture); err != nil {
+		log.ErrorC("Unable to setup test data", err, nil)
+		os.Exit(1)
+	}
+
+	return synthetic, datastore
+}
+
+func teardownSyntheticHierarchy(datastore *neo4j.Datastore) {
+	if err := datastore.TeardownHierarchy(); err != nil {
+		log.ErrorC("Unable to tear down test data", err, nil)
+		os.Exit(1)
+	}
+}
+
+func syntheticLabel(synthetic *neo4j.SyntheticHierarchy, code string) string {
+	for _, n := range synthetic.Definition.Nodes {
+		if n.Code == code {
+			return n.Label
+		}
+	}
+	return ""
+}
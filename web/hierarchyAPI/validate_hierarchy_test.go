@@ -0,0 +1,99 @@
+package hierarchyAPI
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/ONSdigital/dp-api-tests/helpers/hierarchy"
+	"github.com/ONSdigital/dp-api-tests/helpers/v4"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/neo4j"
+	"github.com/ONSdigital/go-ns/log"
+	uuid "github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHierarchyInvariants(t *testing.T) {
+	instanceID := uuid.NewV4().String()
+
+	Convey("Given an existing hierarchy for an instance with dimension options", t, func() {
+
+		datastore, err := neo4j.SetupInstance(cfg.Neo4jAddr, instanceID, neo4j.BuildCPIHInstance)
+		if err != nil {
+			log.ErrorC("Unable to setup graph data", err, nil)
+			os.Exit(1)
+		}
+		setupCPIHCodeList(datastore)
+
+		dimensionOptions, err := datastore.GetDimensionOptions(instanceID)
+		if err != nil {
+			log.ErrorC("Unable to get dimension options", err, nil)
+			os.Exit(1)
+		}
+
+		nodes, err := datastore.GetHierarchyNodes(instanceID, "aggregate")
+		if err != nil {
+			log.ErrorC("Unable to get hierarchy nodes", err, nil)
+			os.Exit(1)
+		}
+
+		options := make(map[string]bool)
+		for _, option := range dimensionOptions["aggregate"] {
+			options[option] = true
+		}
+
+		Convey("When the whole hierarchy is walked from the root node", func() {
+
+			validator := &hierarchy.Validator{
+				HierarchyAPIURL: cfg.HierarchyAPIURL,
+				Options:         options,
+				CheckCodeLinks:  true,
+			}
+
+			result, err := validator.Validate(context.Background(), instanceID, "aggregate")
+
+			Convey("Then every node stored for the hierarchy is reached", func() {
+				So(err, ShouldBeNil)
+				So(result.Nodes, ShouldEqual, len(nodes))
+			})
+
+			Convey("Then every invariant holds at each node", func() {
+				So(err, ShouldBeNil)
+				So(result.Violations, ShouldBeEmpty)
+			})
+		})
+
+		if err = datastore.TeardownHierarchy(); err != nil {
+			log.ErrorC("Unable to tear down test data", err, nil)
+			os.Exit(1)
+		}
+	})
+}
+
+// setupCPIHCodeList loads the aggregate code list of the V4 test file, so the
+// code link of every hierarchy node can be followed through the code list API
+func setupCPIHCodeList(datastore *neo4j.Datastore) {
+	file, err := v4.ParseFile(neo4j.V4TestFile)
+	if err != nil {
+		log.ErrorC("Unable to parse v4 file", err, log.Data{"file": neo4j.V4TestFile})
+		os.Exit(1)
+	}
+
+	var codeLists []*neo4j.CodeList
+	for _, cl := range neo4j.NewV4CodeLists(file, "one-off") {
+		if cl.ID == neo4j.CPIHCodeListID {
+			codeLists = append(codeLists, cl)
+		}
+	}
+
+	fixture, err := neo4j.NewCodeListFixture(codeLists)
+	if err != nil {
+		log.ErrorC("Unable to build code list test data", err, nil)
+		os.Exit(1)
+	}
+
+	if _, err = datastore.ReplaceCodeLists(fixture, neo4j.CPIHCodeListID); err != nil {
+		log.ErrorC("Unable to setup code list test data", err, log.Data{"code_list_id": neo4j.CPIHCodeListID})
+		os.Exit(1)
+	}
+}
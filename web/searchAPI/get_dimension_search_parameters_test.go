@@ -0,0 +1,172 @@
+package searchAPI
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gavv/httpexpect"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/ONSdigital/dp-api-tests/helpers"
+	"github.com/ONSdigital/dp-api-tests/helpers/assertions"
+	"github.com/ONSdigital/dp-api-tests/publishing/datasetAPI"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/elasticsearch"
+	"github.com/ONSdigital/dp-api-tests/testDataSetup/mongo"
+	"github.com/ONSdigital/go-ns/log"
+)
+
+// searchTerm matches searchTermTotal documents in testDataSetup/elasticsearch/testData.json
+const (
+	searchTerm      = "Furniture and furnishings"
+	searchTermTotal = 7
+)
+
+func TestSearchQueryParameters(t *testing.T) {
+	datasetID := uuid.NewV4().String()
+	editionID := uuid.NewV4().String()
+	unpublishedDatasetID := uuid.NewV4().String()
+	unpublishedEditionID := uuid.NewV4().String()
+	unindexedDatasetID := uuid.NewV4().String()
+	unindexedEditionID := uuid.NewV4().String()
+	unindexedInstanceID := uuid.NewV4().String()
+	edition := "2017"
+
+	uniqueTimestamp, err := bson.NewMongoTimestamp(time.Now().UTC(), 1)
+	if err != nil {
+		log.ErrorC("unable to generate mongo timestamp", err, nil)
+		t.FailNow()
+	}
+
+	docs := []*mongo.Doc{
+		{
+			Database:   cfg.MongoDB,
+			Collection: collection,
+			Key:        "_id",
+			Value:      datasetID,
+			Update:     validPublishedDatasetData(datasetID),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "editions",
+			Key:        "_id",
+			Value:      editionID,
+			Update:     datasetAPI.ValidPublishedEditionData(datasetID, editionID, edition),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "instances",
+			Key:        "_id",
+			Value:      instanceID,
+			Update:     validPublishedInstanceData(datasetID, edition, instanceID, uniqueTimestamp),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: collection,
+			Key:        "_id",
+			Value:      unpublishedDatasetID,
+			Update:     validPublishedDatasetData(unpublishedDatasetID),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "editions",
+			Key:        "_id",
+			Value:      unpublishedEditionID,
+			Update:     datasetAPI.ValidUnpublishedEditionData(unpublishedDatasetID, unpublishedEditionID, edition),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: collection,
+			Key:        "_id",
+			Value:      unindexedDatasetID,
+			Update:     validPublishedDatasetData(unindexedDatasetID),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "editions",
+			Key:        "_id",
+			Value:      unindexedEditionID,
+			Update:     datasetAPI.ValidPublishedEditionData(unindexedDatasetID, unindexedEditionID, edition),
+		},
+		{
+			Database:   cfg.MongoDB,
+			Collection: "instances",
+			Key:        "_id",
+			Value:      unindexedInstanceID,
+			Update:     validPublishedInstanceData(unindexedDatasetID, edition, unindexedInstanceID, uniqueTimestamp),
+		},
+	}
+
+	if err = mongo.Setup(docs...); err != nil {
+		log.ErrorC("was unable to run test", err, nil)
+		t.FailNow()
+	}
+
+	if err = createSearchIndex(cfg.ElasticSearchAPIURL, instanceID, dimensionKeyAggregate); err != nil {
+		log.ErrorC("Unable to setup elasticsearch index with test data", err, nil)
+		t.FailNow()
+	}
+
+	searchAPI := httpexpect.New(t, cfg.SearchAPIURL)
+	path := "/search/datasets/{datasetID}/editions/{edition}/versions/{version}/dimensions/{dimension}"
+
+	Convey("Given an existing version for an edition of a dataset is published", t, func() {
+		for _, c := range helpers.SearchParameterCases(searchTerm, searchTermTotal, helpers.SearchMaxResults) {
+			c := c
+
+			Convey("When a GET request is made with "+c.Description, func() {
+				assertions.CheckSearchParameterCase(searchAPI.GET(path, datasetID, edition, "1", dimensionKeyAggregate), c)
+			})
+		}
+
+		Convey("When a GET request is made for a dimension that is not indexed", func() {
+			Convey("Then the response returns not found (404)", func() {
+
+				searchAPI.GET(path, datasetID, edition, "1", "unknown").
+					WithQuery("q", searchTerm).
+					Expect().Status(http.StatusNotFound).Body().Contains("search index not found\n")
+			})
+		})
+	})
+
+	Convey("Given an edition of a published dataset is unpublished", t, func() {
+		Convey("When a GET request is made with a search term", func() {
+			Convey("Then the response returns not found (404)", func() {
+
+				searchAPI.GET(path, unpublishedDatasetID, edition, "1", dimensionKeyAggregate).
+					WithQuery("q", searchTerm).
+					Expect().Status(http.StatusNotFound).Body().Contains("edition not found\n")
+			})
+		})
+	})
+
+	Convey("Given a published version for an edition of a dataset has no search index", t, func() {
+		Convey("When a GET request is made with a search term", func() {
+			Convey("Then the response returns not found (404)", func() {
+
+				searchAPI.GET(path, unindexedDatasetID, edition, "1", dimensionKeyAggregate).
+					WithQuery("q", searchTerm).
+					Expect().Status(http.StatusNotFound).Body().Contains("search index not found\n")
+			})
+		})
+	})
+
+	if skipTeardown {
+		return
+	}
+
+	if err = mongo.Teardown(docs...); err != nil {
+		if err != mgo.ErrNotFound {
+			log.ErrorC("was unable to remove test data", err, nil)
+			t.FailNow()
+		}
+	}
+
+	if status, err := elasticsearch.DeleteIndex(cfg.ElasticSearchAPIURL + "/" + instanceID + "_" + dimensionKeyAggregate); err != nil {
+		log.ErrorC("failed to remove elastic search index", err, log.Data{"status_code": status})
+		t.FailNow()
+	}
+}
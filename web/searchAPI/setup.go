@@ -19,11 +19,12 @@ func createSearchIndex(url, instanceID, dimension string) error {
 	}
 
 	index := elasticsearch.Index{
-		InstanceID:   instanceID,
-		Dimension:    dimension,
-		TestDataFile: directory + "/testDataSetup/elasticsearch/testData.json",
-		URL:          url,
-		MappingsFile: directory + "/testDataSetup/elasticsearch/mappings.json",
+		InstanceID:           instanceID,
+		Dimension:            dimension,
+		TestDataFile:         directory + "/testDataSetup/elasticsearch/testData.json",
+		URL:                  url,
+		MappingsFile:         directory + "/testDataSetup/elasticsearch/mappings.json",
+		TypelessMappingsFile: directory + "/testDataSetup/elasticsearch/typelessMappings.json",
 	}
 
 	if err := index.CreateSearchIndex(); err != nil {